@@ -0,0 +1,97 @@
+// Package app builds the root kingpin application and registers every
+// command family onto it.
+package app
+
+import (
+	kingpin "gopkg.in/alecthomas/kingpin.v2"
+
+	"github.com/fastly/cli/pkg/common"
+	"github.com/fastly/cli/pkg/config"
+	"github.com/fastly/cli/pkg/logging/azureblob"
+	"github.com/fastly/cli/pkg/logging/bigquery"
+	"github.com/fastly/cli/pkg/logging/digitalocean"
+	"github.com/fastly/cli/pkg/logging/gcs"
+	"github.com/fastly/cli/pkg/logging/openstack"
+	"github.com/fastly/cli/pkg/logging/sftp"
+	"github.com/fastly/cli/pkg/service"
+	"github.com/fastly/cli/pkg/vcl/custom"
+	"github.com/fastly/cli/pkg/vcl/snippet"
+)
+
+// Run constructs the kingpin application and registers every command
+// family's Create/Update/Delete/Describe/List commands onto it. It returns
+// the application, ready to Parse(args), alongside a map from matched
+// command name to the common.Runner that should handle it.
+func Run(globals *config.Data) (*kingpin.Application, map[string]common.Runner) {
+	app := kingpin.New("fastly", "A tool to interact with the Fastly API")
+	commands := make(map[string]common.Runner)
+
+	register := func(r common.Runner) {
+		commands[r.Name()] = r
+	}
+
+	loggingCmd := app.Command("logging", "Manipulate Fastly service version logging endpoints")
+
+	gcsCmd := loggingCmd.Command("gcs", "Google Cloud Storage logging endpoints")
+	register(gcs.NewCreateCommand(gcsCmd, globals))
+	register(gcs.NewUpdateCommand(gcsCmd, globals))
+	register(gcs.NewDeleteCommand(gcsCmd, globals))
+	register(gcs.NewDescribeCommand(gcsCmd, globals))
+	register(gcs.NewListCommand(gcsCmd, globals))
+
+	digitalOceanCmd := loggingCmd.Command("digitalocean", "DigitalOcean Spaces logging endpoints")
+	register(digitalocean.NewCreateCommand(digitalOceanCmd, globals))
+	register(digitalocean.NewUpdateCommand(digitalOceanCmd, globals))
+	register(digitalocean.NewDeleteCommand(digitalOceanCmd, globals))
+	register(digitalocean.NewDescribeCommand(digitalOceanCmd, globals))
+	register(digitalocean.NewListCommand(digitalOceanCmd, globals))
+
+	openstackCmd := loggingCmd.Command("openstack", "OpenStack Swift logging endpoints")
+	register(openstack.NewCreateCommand(openstackCmd, globals))
+	register(openstack.NewUpdateCommand(openstackCmd, globals))
+	register(openstack.NewDeleteCommand(openstackCmd, globals))
+	register(openstack.NewDescribeCommand(openstackCmd, globals))
+	register(openstack.NewListCommand(openstackCmd, globals))
+
+	azureBlobCmd := loggingCmd.Command("azureblob", "Azure Blob Storage logging endpoints")
+	register(azureblob.NewCreateCommand(azureBlobCmd, globals))
+	register(azureblob.NewUpdateCommand(azureBlobCmd, globals))
+	register(azureblob.NewDeleteCommand(azureBlobCmd, globals))
+	register(azureblob.NewDescribeCommand(azureBlobCmd, globals))
+	register(azureblob.NewListCommand(azureBlobCmd, globals))
+
+	sftpCmd := loggingCmd.Command("sftp", "SFTP logging endpoints")
+	register(sftp.NewCreateCommand(sftpCmd, globals))
+	register(sftp.NewUpdateCommand(sftpCmd, globals))
+	register(sftp.NewDeleteCommand(sftpCmd, globals))
+	register(sftp.NewDescribeCommand(sftpCmd, globals))
+	register(sftp.NewListCommand(sftpCmd, globals))
+
+	bigQueryCmd := loggingCmd.Command("bigquery", "BigQuery logging endpoints")
+	register(bigquery.NewCreateCommand(bigQueryCmd, globals))
+	register(bigquery.NewUpdateCommand(bigQueryCmd, globals))
+	register(bigquery.NewDeleteCommand(bigQueryCmd, globals))
+	register(bigquery.NewDescribeCommand(bigQueryCmd, globals))
+	register(bigquery.NewListCommand(bigQueryCmd, globals))
+
+	vclCmd := app.Command("vcl", "Manipulate custom VCL and VCL snippets on a Fastly service version")
+
+	customCmd := vclCmd.Command("custom", "Custom VCL")
+	register(custom.NewCreateCommand(customCmd, globals))
+	register(custom.NewUpdateCommand(customCmd, globals))
+	register(custom.NewDeleteCommand(customCmd, globals))
+	register(custom.NewDescribeCommand(customCmd, globals))
+	register(custom.NewListCommand(customCmd, globals))
+
+	snippetCmd := vclCmd.Command("snippet", "VCL snippets")
+	register(snippet.NewCreateCommand(snippetCmd, globals))
+	register(snippet.NewUpdateCommand(snippetCmd, globals))
+	register(snippet.NewDeleteCommand(snippetCmd, globals))
+	register(snippet.NewDescribeCommand(snippetCmd, globals))
+	register(snippet.NewListCommand(snippetCmd, globals))
+
+	serviceCmd := app.Command("service", "Manage Fastly services")
+	register(service.NewApplyCommand(serviceCmd, globals))
+
+	return app, commands
+}
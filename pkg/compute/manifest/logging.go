@@ -0,0 +1,215 @@
+package manifest
+
+import (
+	"fmt"
+	"os"
+	"regexp"
+)
+
+// secretRef matches a fastly.toml value that is entirely a reference to an
+// environment variable, e.g. "${GCS_SECRET_KEY}".
+var secretRef = regexp.MustCompile(`^\$\{([A-Za-z_][A-Za-z0-9_]*)\}$`)
+
+// resolveSecret expands a value of the form "${VAR_NAME}" to the current
+// value of the named environment variable, leaving any other value
+// untouched. fastly.toml is meant to be committed to source control (see
+// Logging), so secret fields should hold an env var reference rather than
+// the credential itself; resolveSecret is how that indirection is read back
+// out after Read unmarshals the file.
+//
+// It is an error for the referenced environment variable to be unset: a
+// silent fall-through to "" would flow straight into `service apply`'s diff
+// as a real, differing value and overlay an empty credential onto the live
+// endpoint, wiping it out.
+func resolveSecret(value string) (string, error) {
+	m := secretRef.FindStringSubmatch(value)
+	if m == nil {
+		return value, nil
+	}
+
+	name := m[1]
+	resolved, ok := os.LookupEnv(name)
+	if !ok {
+		return "", fmt.Errorf("fastly.toml references ${%s} but it is not set in the environment", name)
+	}
+
+	return resolved, nil
+}
+
+// Logging declares the complete set of logging endpoints for a service, as
+// read from the [logging] table of fastly.toml. Each slice is keyed by the
+// endpoint's Name field, and is reconciled against the live service version
+// by `fastly service apply`.
+//
+// fastly.toml is a GitOps artifact meant to be committed, so credential
+// fields (SecretKey, Password, SASToken, Token, and so on) should never hold
+// a literal secret. Declare them as "${ENV_VAR_NAME}" instead; resolveSecrets
+// expands that reference against the environment once the file is read.
+type Logging struct {
+	GCS          []GCSEndpoint          `toml:"gcs"`
+	S3           []S3Endpoint           `toml:"s3"`
+	DigitalOcean []DigitalOceanEndpoint `toml:"digitalocean"`
+	Openstack    []OpenstackEndpoint    `toml:"openstack"`
+	AzureBlob    []AzureBlobEndpoint    `toml:"azureblob"`
+	BigQuery     []BigQueryEndpoint     `toml:"bigquery"`
+	SFTP         []SFTPEndpoint         `toml:"sftp"`
+	Syslog       []SyslogEndpoint       `toml:"syslog"`
+	Sumologic    []SumologicEndpoint    `toml:"sumologic"`
+}
+
+// GCSEndpoint declares a GCS logging endpoint.
+type GCSEndpoint struct {
+	Name      string `toml:"name"`
+	Bucket    string `toml:"bucket"`
+	User      string `toml:"user"`
+	SecretKey string `toml:"secret_key"`
+	Path      string `toml:"path"`
+}
+
+// S3Endpoint declares an Amazon S3 logging endpoint.
+type S3Endpoint struct {
+	Name      string `toml:"name"`
+	Bucket    string `toml:"bucket"`
+	AccessKey string `toml:"access_key"`
+	SecretKey string `toml:"secret_key"`
+	Domain    string `toml:"domain"`
+	Path      string `toml:"path"`
+}
+
+// DigitalOceanEndpoint declares a DigitalOcean Spaces logging endpoint.
+type DigitalOceanEndpoint struct {
+	Name      string `toml:"name"`
+	Bucket    string `toml:"bucket"`
+	AccessKey string `toml:"access_key"`
+	SecretKey string `toml:"secret_key"`
+	Domain    string `toml:"domain"`
+	Path      string `toml:"path"`
+}
+
+// OpenstackEndpoint declares an OpenStack logging endpoint.
+type OpenstackEndpoint struct {
+	Name      string `toml:"name"`
+	Bucket    string `toml:"bucket"`
+	AccessKey string `toml:"access_key"`
+	User      string `toml:"user"`
+	URL       string `toml:"url"`
+	Path      string `toml:"path"`
+}
+
+// AzureBlobEndpoint declares an Azure Blob Storage logging endpoint.
+type AzureBlobEndpoint struct {
+	Name        string `toml:"name"`
+	Container   string `toml:"container"`
+	AccountName string `toml:"account_name"`
+	SASToken    string `toml:"sas_token"`
+	Path        string `toml:"path"`
+}
+
+// BigQueryEndpoint declares a BigQuery logging endpoint.
+type BigQueryEndpoint struct {
+	Name           string `toml:"name"`
+	ProjectID      string `toml:"project_id"`
+	Dataset        string `toml:"dataset"`
+	Table          string `toml:"table"`
+	User           string `toml:"user"`
+	SecretKey      string `toml:"secret_key"`
+	TemplateSuffix string `toml:"template_suffix"`
+}
+
+// SFTPEndpoint declares an SFTP logging endpoint.
+type SFTPEndpoint struct {
+	Name          string `toml:"name"`
+	Address       string `toml:"address"`
+	Port          uint   `toml:"port"`
+	User          string `toml:"user"`
+	Password      string `toml:"password"`
+	SSHKnownHosts string `toml:"ssh_known_hosts"`
+	SecretKey     string `toml:"secret_key"`
+	Path          string `toml:"path"`
+}
+
+// SyslogEndpoint declares a Syslog logging endpoint.
+type SyslogEndpoint struct {
+	Name    string `toml:"name"`
+	Address string `toml:"address"`
+	Port    uint   `toml:"port"`
+	Token   string `toml:"token"`
+	UseTLS  bool   `toml:"use_tls"`
+}
+
+// SumologicEndpoint declares a Sumo Logic logging endpoint.
+type SumologicEndpoint struct {
+	Name string `toml:"name"`
+	URL  string `toml:"url"`
+}
+
+// resolveSecrets expands any "${ENV_VAR_NAME}" reference held in a secret
+// field across every declared endpoint, in place. It returns an error
+// without modifying further endpoints as soon as a reference can't be
+// resolved, so Read() fails loudly instead of handing `service apply` an
+// empty credential to diff and overlay.
+func (l *Logging) resolveSecrets() error {
+	for i := range l.GCS {
+		resolved, err := resolveSecret(l.GCS[i].SecretKey)
+		if err != nil {
+			return err
+		}
+		l.GCS[i].SecretKey = resolved
+	}
+	for i := range l.S3 {
+		resolved, err := resolveSecret(l.S3[i].SecretKey)
+		if err != nil {
+			return err
+		}
+		l.S3[i].SecretKey = resolved
+	}
+	for i := range l.DigitalOcean {
+		resolved, err := resolveSecret(l.DigitalOcean[i].SecretKey)
+		if err != nil {
+			return err
+		}
+		l.DigitalOcean[i].SecretKey = resolved
+	}
+	for i := range l.Openstack {
+		resolved, err := resolveSecret(l.Openstack[i].AccessKey)
+		if err != nil {
+			return err
+		}
+		l.Openstack[i].AccessKey = resolved
+	}
+	for i := range l.AzureBlob {
+		resolved, err := resolveSecret(l.AzureBlob[i].SASToken)
+		if err != nil {
+			return err
+		}
+		l.AzureBlob[i].SASToken = resolved
+	}
+	for i := range l.BigQuery {
+		resolved, err := resolveSecret(l.BigQuery[i].SecretKey)
+		if err != nil {
+			return err
+		}
+		l.BigQuery[i].SecretKey = resolved
+	}
+	for i := range l.SFTP {
+		resolved, err := resolveSecret(l.SFTP[i].Password)
+		if err != nil {
+			return err
+		}
+		l.SFTP[i].Password = resolved
+
+		resolved, err = resolveSecret(l.SFTP[i].SecretKey)
+		if err != nil {
+			return err
+		}
+		l.SFTP[i].SecretKey = resolved
+	}
+	for i := range l.Syslog {
+		resolved, err := resolveSecret(l.Syslog[i].Token)
+		if err != nil {
+			return err
+		}
+		l.Syslog[i].Token = resolved
+	}
+	return nil
+}
@@ -0,0 +1,103 @@
+// Package manifest manages the manifest data written to, and read from, the
+// project's fastly.toml file.
+package manifest
+
+import (
+	"os"
+
+	toml "github.com/pelletier/go-toml"
+)
+
+// Filename is the name of the package manifest file.
+// It is expected to be found in the current working directory,
+// from where the fastly binary is executed.
+const Filename = "fastly.toml"
+
+// Source enumerates where a manifest value was set from.
+type Source uint8
+
+const (
+	// SourceUndefined indicates the value was not set from any source.
+	SourceUndefined Source = iota
+	// SourceFile indicates the value was set from fastly.toml.
+	SourceFile
+	// SourceFlag indicates the value was set from an explicit flag.
+	SourceFlag
+	// SourceEnv indicates the value was set from an environment variable.
+	SourceEnv
+)
+
+// Data holds global-ish manifest data from standard input, package files, and
+// command line flags. It's one of the things every command has available.
+type Data struct {
+	File File
+	Flag Flag
+}
+
+// ServiceID yields the service ID from the manifest data, preferring an
+// explicit --service-id flag, then the FASTLY_SERVICE_ID environment
+// variable, then falling back to the value declared in fastly.toml.
+func (d *Data) ServiceID() (string, Source) {
+	if d.Flag.ServiceID != "" {
+		return d.Flag.ServiceID, SourceFlag
+	}
+
+	if env := os.Getenv("FASTLY_SERVICE_ID"); env != "" {
+		return env, SourceEnv
+	}
+
+	if d.File.ServiceID != "" {
+		return d.File.ServiceID, SourceFile
+	}
+
+	return "", SourceUndefined
+}
+
+// Flag represents manifest data that can be overridden via explicit flags.
+type Flag struct {
+	ServiceID string
+}
+
+// File represents all of the configuration parameters in the fastly.toml
+// configuration file. Values are populated from the toml file during Read.
+type File struct {
+	ServiceID   string   `toml:"service_id"`
+	Name        string   `toml:"name"`
+	Description string   `toml:"description"`
+	Authors     []string `toml:"authors"`
+	Language    string   `toml:"language"`
+
+	Logging Logging `toml:"logging"`
+
+	exists bool
+}
+
+// Exists yields whether the manifest was successfully read from disk.
+func (f *File) Exists() bool {
+	return f.exists
+}
+
+// Read loads the fastly.toml manifest from filename into f. A missing file
+// is not an error: commands fall back to flags/env vars for any values they
+// need, exactly as if an empty manifest had been read.
+func (f *File) Read(filename string) error {
+	if _, err := os.Stat(filename); err != nil {
+		return nil
+	}
+
+	tree, err := toml.LoadFile(filename)
+	if err != nil {
+		return err
+	}
+
+	if err := tree.Unmarshal(f); err != nil {
+		return err
+	}
+
+	if err := f.Logging.resolveSecrets(); err != nil {
+		return err
+	}
+
+	f.exists = true
+	return nil
+}
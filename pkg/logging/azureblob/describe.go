@@ -0,0 +1,74 @@
+package azureblob
+
+import (
+	"fmt"
+	"io"
+
+	"github.com/fastly/cli/pkg/common"
+	"github.com/fastly/cli/pkg/compute/manifest"
+	"github.com/fastly/cli/pkg/config"
+	"github.com/fastly/cli/pkg/errors"
+	"github.com/fastly/go-fastly/fastly"
+)
+
+// DescribeCommand calls the Fastly API to describe an Azure Blob Storage logging endpoint.
+type DescribeCommand struct {
+	common.Base
+	manifest manifest.Data
+
+	// required
+	EndpointName string // Can't shaddow common.Base method Name().
+	Version      int
+}
+
+// NewDescribeCommand returns a usable command registered under the parent.
+func NewDescribeCommand(parent common.Registerer, globals *config.Data) *DescribeCommand {
+	var c DescribeCommand
+	c.Globals = globals
+	c.manifest.File.Read(manifest.Filename)
+
+	c.CmdClause = parent.Command("describe", "Show detailed information about an Azure Blob Storage logging endpoint on a Fastly service version").Alias("get")
+
+	c.CmdClause.Flag("service-id", "Service ID").Short('s').StringVar(&c.manifest.Flag.ServiceID)
+	c.CmdClause.Flag("version", "Number of service version").Required().IntVar(&c.Version)
+	c.CmdClause.Flag("name", "The name of the Azure Blob Storage logging object").Short('n').Required().StringVar(&c.EndpointName)
+
+	return &c
+}
+
+// Exec invokes the application logic for the command.
+func (c *DescribeCommand) Exec(in io.Reader, out io.Writer) error {
+	serviceID, source := c.manifest.ServiceID()
+	if source == manifest.SourceUndefined {
+		return errors.ErrNoServiceID
+	}
+
+	a, err := c.Globals.Client.GetBlobStorage(&fastly.GetBlobStorageInput{
+		Service: serviceID,
+		Name:    c.EndpointName,
+		Version: c.Version,
+	})
+	if err != nil {
+		return err
+	}
+
+	fmt.Fprintf(out, "Service ID: %s\n", a.ServiceID)
+	fmt.Fprintf(out, "Version: %d\n", a.Version)
+	fmt.Fprintf(out, "Name: %s\n", a.Name)
+	fmt.Fprintf(out, "Container: %s\n", a.Container)
+	fmt.Fprintf(out, "Account name: %s\n", a.AccountName)
+	fmt.Fprintf(out, "SAS token: %s\n", a.SASToken)
+	fmt.Fprintf(out, "Path: %s\n", a.Path)
+	fmt.Fprintf(out, "Period: %d\n", a.Period)
+	fmt.Fprintf(out, "GZip level: %d\n", a.GzipLevel)
+	fmt.Fprintf(out, "Format: %s\n", a.Format)
+	fmt.Fprintf(out, "Format version: %d\n", a.FormatVersion)
+	fmt.Fprintf(out, "Response condition: %s\n", a.ResponseCondition)
+	fmt.Fprintf(out, "Timestamp format: %s\n", a.TimestampFormat)
+	fmt.Fprintf(out, "Placement: %s\n", a.Placement)
+	fmt.Fprintf(out, "Public key: %s\n", a.PublicKey)
+	fmt.Fprintf(out, "Message type: %s\n", a.MessageType)
+	fmt.Fprintf(out, "File max bytes: %d\n", a.FileMaxBytes)
+
+	return nil
+}
@@ -0,0 +1,84 @@
+package azureblob
+
+import (
+	"fmt"
+	"io"
+
+	"github.com/fastly/cli/pkg/common"
+	"github.com/fastly/cli/pkg/compute/manifest"
+	"github.com/fastly/cli/pkg/config"
+	"github.com/fastly/cli/pkg/errors"
+	"github.com/fastly/cli/pkg/text"
+	"github.com/fastly/go-fastly/fastly"
+)
+
+// ListCommand calls the Fastly API to list Azure Blob Storage logging endpoints.
+type ListCommand struct {
+	common.Base
+	manifest manifest.Data
+	Version  int
+}
+
+// NewListCommand returns a usable command registered under the parent.
+func NewListCommand(parent common.Registerer, globals *config.Data) *ListCommand {
+	var c ListCommand
+	c.Globals = globals
+	c.manifest.File.Read(manifest.Filename)
+
+	c.CmdClause = parent.Command("list", "List Azure Blob Storage logging endpoints on a Fastly service version")
+
+	c.CmdClause.Flag("service-id", "Service ID").Short('s').StringVar(&c.manifest.Flag.ServiceID)
+	c.CmdClause.Flag("version", "Number of service version").Required().IntVar(&c.Version)
+
+	return &c
+}
+
+// Exec invokes the application logic for the command.
+func (c *ListCommand) Exec(in io.Reader, out io.Writer) error {
+	serviceID, source := c.manifest.ServiceID()
+	if source == manifest.SourceUndefined {
+		return errors.ErrNoServiceID
+	}
+
+	as, err := c.Globals.Client.ListBlobStorages(&fastly.ListBlobStoragesInput{
+		Service: serviceID,
+		Version: c.Version,
+	})
+	if err != nil {
+		return err
+	}
+
+	if !c.Globals.Verbose() {
+		tw := text.NewTable(out)
+		tw.AddHeader("SERVICE", "VERSION", "NAME", "CONTAINER")
+		for _, a := range as {
+			tw.AddLine(a.ServiceID, a.Version, a.Name, a.Container)
+		}
+		tw.Print()
+		return nil
+	}
+
+	for i, a := range as {
+		fmt.Fprintf(out, "Service ID: %s\n", a.ServiceID)
+		fmt.Fprintf(out, "Version: %d\n", a.Version)
+		fmt.Fprintf(out, "\tAzure Blob Storage %d/%d\n", i+1, len(as))
+		fmt.Fprintf(out, "\t\tName: %s\n", a.Name)
+		fmt.Fprintf(out, "\t\tContainer: %s\n", a.Container)
+		fmt.Fprintf(out, "\t\tAccount name: %s\n", a.AccountName)
+		fmt.Fprintf(out, "\t\tSAS token: %s\n", a.SASToken)
+		fmt.Fprintf(out, "\t\tPath: %s\n", a.Path)
+		fmt.Fprintf(out, "\t\tPeriod: %d\n", a.Period)
+		fmt.Fprintf(out, "\t\tGZip level: %d\n", a.GzipLevel)
+		fmt.Fprintf(out, "\t\tFormat: %s\n", a.Format)
+		fmt.Fprintf(out, "\t\tFormat version: %d\n", a.FormatVersion)
+		fmt.Fprintf(out, "\t\tResponse condition: %s\n", a.ResponseCondition)
+		fmt.Fprintf(out, "\t\tTimestamp format: %s\n", a.TimestampFormat)
+		fmt.Fprintf(out, "\t\tPlacement: %s\n", a.Placement)
+		fmt.Fprintf(out, "\t\tPublic key: %s\n", a.PublicKey)
+		fmt.Fprintf(out, "\t\tMessage type: %s\n", a.MessageType)
+		fmt.Fprintf(out, "\t\tFile max bytes: %d\n", a.FileMaxBytes)
+	}
+	fmt.Fprintln(out)
+
+	return nil
+}
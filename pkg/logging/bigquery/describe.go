@@ -0,0 +1,69 @@
+package bigquery
+
+import (
+	"fmt"
+	"io"
+
+	"github.com/fastly/cli/pkg/common"
+	"github.com/fastly/cli/pkg/compute/manifest"
+	"github.com/fastly/cli/pkg/config"
+	"github.com/fastly/cli/pkg/errors"
+	"github.com/fastly/go-fastly/fastly"
+)
+
+// DescribeCommand calls the Fastly API to describe a BigQuery logging endpoint.
+type DescribeCommand struct {
+	common.Base
+	manifest manifest.Data
+
+	// required
+	EndpointName string // Can't shaddow common.Base method Name().
+	Version      int
+}
+
+// NewDescribeCommand returns a usable command registered under the parent.
+func NewDescribeCommand(parent common.Registerer, globals *config.Data) *DescribeCommand {
+	var c DescribeCommand
+	c.Globals = globals
+	c.manifest.File.Read(manifest.Filename)
+
+	c.CmdClause = parent.Command("describe", "Show detailed information about a BigQuery logging endpoint on a Fastly service version").Alias("get")
+
+	c.CmdClause.Flag("service-id", "Service ID").Short('s').StringVar(&c.manifest.Flag.ServiceID)
+	c.CmdClause.Flag("version", "Number of service version").Required().IntVar(&c.Version)
+	c.CmdClause.Flag("name", "The name of the BigQuery logging object").Short('n').Required().StringVar(&c.EndpointName)
+
+	return &c
+}
+
+// Exec invokes the application logic for the command.
+func (c *DescribeCommand) Exec(in io.Reader, out io.Writer) error {
+	serviceID, source := c.manifest.ServiceID()
+	if source == manifest.SourceUndefined {
+		return errors.ErrNoServiceID
+	}
+
+	b, err := c.Globals.Client.GetBigQuery(&fastly.GetBigQueryInput{
+		Service: serviceID,
+		Name:    c.EndpointName,
+		Version: c.Version,
+	})
+	if err != nil {
+		return err
+	}
+
+	fmt.Fprintf(out, "Service ID: %s\n", b.ServiceID)
+	fmt.Fprintf(out, "Version: %d\n", b.Version)
+	fmt.Fprintf(out, "Name: %s\n", b.Name)
+	fmt.Fprintf(out, "Project ID: %s\n", b.ProjectID)
+	fmt.Fprintf(out, "Dataset: %s\n", b.Dataset)
+	fmt.Fprintf(out, "Table: %s\n", b.Table)
+	fmt.Fprintf(out, "User: %s\n", b.User)
+	fmt.Fprintf(out, "Secret key: %s\n", b.SecretKey)
+	fmt.Fprintf(out, "Template suffix: %s\n", b.TemplateSuffix)
+	fmt.Fprintf(out, "Format: %s\n", b.Format)
+	fmt.Fprintf(out, "Response condition: %s\n", b.ResponseCondition)
+	fmt.Fprintf(out, "Placement: %s\n", b.Placement)
+
+	return nil
+}
@@ -0,0 +1,79 @@
+package bigquery
+
+import (
+	"fmt"
+	"io"
+
+	"github.com/fastly/cli/pkg/common"
+	"github.com/fastly/cli/pkg/compute/manifest"
+	"github.com/fastly/cli/pkg/config"
+	"github.com/fastly/cli/pkg/errors"
+	"github.com/fastly/cli/pkg/text"
+	"github.com/fastly/go-fastly/fastly"
+)
+
+// ListCommand calls the Fastly API to list BigQuery logging endpoints.
+type ListCommand struct {
+	common.Base
+	manifest manifest.Data
+	Version  int
+}
+
+// NewListCommand returns a usable command registered under the parent.
+func NewListCommand(parent common.Registerer, globals *config.Data) *ListCommand {
+	var c ListCommand
+	c.Globals = globals
+	c.manifest.File.Read(manifest.Filename)
+
+	c.CmdClause = parent.Command("list", "List BigQuery logging endpoints on a Fastly service version")
+
+	c.CmdClause.Flag("service-id", "Service ID").Short('s').StringVar(&c.manifest.Flag.ServiceID)
+	c.CmdClause.Flag("version", "Number of service version").Required().IntVar(&c.Version)
+
+	return &c
+}
+
+// Exec invokes the application logic for the command.
+func (c *ListCommand) Exec(in io.Reader, out io.Writer) error {
+	serviceID, source := c.manifest.ServiceID()
+	if source == manifest.SourceUndefined {
+		return errors.ErrNoServiceID
+	}
+
+	bqs, err := c.Globals.Client.ListBigQueries(&fastly.ListBigQueriesInput{
+		Service: serviceID,
+		Version: c.Version,
+	})
+	if err != nil {
+		return err
+	}
+
+	if !c.Globals.Verbose() {
+		tw := text.NewTable(out)
+		tw.AddHeader("SERVICE", "VERSION", "NAME", "DATASET", "TABLE")
+		for _, b := range bqs {
+			tw.AddLine(b.ServiceID, b.Version, b.Name, b.Dataset, b.Table)
+		}
+		tw.Print()
+		return nil
+	}
+
+	for i, b := range bqs {
+		fmt.Fprintf(out, "Service ID: %s\n", b.ServiceID)
+		fmt.Fprintf(out, "Version: %d\n", b.Version)
+		fmt.Fprintf(out, "\tBigQuery %d/%d\n", i+1, len(bqs))
+		fmt.Fprintf(out, "\t\tName: %s\n", b.Name)
+		fmt.Fprintf(out, "\t\tProject ID: %s\n", b.ProjectID)
+		fmt.Fprintf(out, "\t\tDataset: %s\n", b.Dataset)
+		fmt.Fprintf(out, "\t\tTable: %s\n", b.Table)
+		fmt.Fprintf(out, "\t\tUser: %s\n", b.User)
+		fmt.Fprintf(out, "\t\tSecret key: %s\n", b.SecretKey)
+		fmt.Fprintf(out, "\t\tTemplate suffix: %s\n", b.TemplateSuffix)
+		fmt.Fprintf(out, "\t\tFormat: %s\n", b.Format)
+		fmt.Fprintf(out, "\t\tResponse condition: %s\n", b.ResponseCondition)
+		fmt.Fprintf(out, "\t\tPlacement: %s\n", b.Placement)
+	}
+	fmt.Fprintln(out)
+
+	return nil
+}
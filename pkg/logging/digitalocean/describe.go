@@ -0,0 +1,74 @@
+package digitalocean
+
+import (
+	"fmt"
+	"io"
+
+	"github.com/fastly/cli/pkg/common"
+	"github.com/fastly/cli/pkg/compute/manifest"
+	"github.com/fastly/cli/pkg/config"
+	"github.com/fastly/cli/pkg/errors"
+	"github.com/fastly/go-fastly/fastly"
+)
+
+// DescribeCommand calls the Fastly API to describe a DigitalOcean Spaces logging endpoint.
+type DescribeCommand struct {
+	common.Base
+	manifest manifest.Data
+
+	// required
+	EndpointName string // Can't shaddow common.Base method Name().
+	Version      int
+}
+
+// NewDescribeCommand returns a usable command registered under the parent.
+func NewDescribeCommand(parent common.Registerer, globals *config.Data) *DescribeCommand {
+	var c DescribeCommand
+	c.Globals = globals
+	c.manifest.File.Read(manifest.Filename)
+
+	c.CmdClause = parent.Command("describe", "Show detailed information about a DigitalOcean Spaces logging endpoint on a Fastly service version").Alias("get")
+
+	c.CmdClause.Flag("service-id", "Service ID").Short('s').StringVar(&c.manifest.Flag.ServiceID)
+	c.CmdClause.Flag("version", "Number of service version").Required().IntVar(&c.Version)
+	c.CmdClause.Flag("name", "The name of the DigitalOcean Spaces logging object").Short('n').Required().StringVar(&c.EndpointName)
+
+	return &c
+}
+
+// Exec invokes the application logic for the command.
+func (c *DescribeCommand) Exec(in io.Reader, out io.Writer) error {
+	serviceID, source := c.manifest.ServiceID()
+	if source == manifest.SourceUndefined {
+		return errors.ErrNoServiceID
+	}
+
+	do, err := c.Globals.Client.GetDigitalOcean(&fastly.GetDigitalOceanInput{
+		Service: serviceID,
+		Name:    c.EndpointName,
+		Version: c.Version,
+	})
+	if err != nil {
+		return err
+	}
+
+	fmt.Fprintf(out, "Service ID: %s\n", do.ServiceID)
+	fmt.Fprintf(out, "Version: %d\n", do.Version)
+	fmt.Fprintf(out, "Name: %s\n", do.Name)
+	fmt.Fprintf(out, "Bucket: %s\n", do.BucketName)
+	fmt.Fprintf(out, "Domain: %s\n", do.Domain)
+	fmt.Fprintf(out, "Access key: %s\n", do.AccessKey)
+	fmt.Fprintf(out, "Secret key: %s\n", do.SecretKey)
+	fmt.Fprintf(out, "Path: %s\n", do.Path)
+	fmt.Fprintf(out, "Period: %d\n", do.Period)
+	fmt.Fprintf(out, "GZip level: %d\n", do.GzipLevel)
+	fmt.Fprintf(out, "Format: %s\n", do.Format)
+	fmt.Fprintf(out, "Format version: %d\n", do.FormatVersion)
+	fmt.Fprintf(out, "Response condition: %s\n", do.ResponseCondition)
+	fmt.Fprintf(out, "Timestamp format: %s\n", do.TimestampFormat)
+	fmt.Fprintf(out, "Placement: %s\n", do.Placement)
+	fmt.Fprintf(out, "Public key: %s\n", do.PublicKey)
+	fmt.Fprintf(out, "Message type: %s\n", do.MessageType)
+
+	return nil
+}
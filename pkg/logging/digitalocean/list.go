@@ -0,0 +1,84 @@
+package digitalocean
+
+import (
+	"fmt"
+	"io"
+
+	"github.com/fastly/cli/pkg/common"
+	"github.com/fastly/cli/pkg/compute/manifest"
+	"github.com/fastly/cli/pkg/config"
+	"github.com/fastly/cli/pkg/errors"
+	"github.com/fastly/cli/pkg/text"
+	"github.com/fastly/go-fastly/fastly"
+)
+
+// ListCommand calls the Fastly API to list DigitalOcean Spaces logging endpoints.
+type ListCommand struct {
+	common.Base
+	manifest manifest.Data
+	Version  int
+}
+
+// NewListCommand returns a usable command registered under the parent.
+func NewListCommand(parent common.Registerer, globals *config.Data) *ListCommand {
+	var c ListCommand
+	c.Globals = globals
+	c.manifest.File.Read(manifest.Filename)
+
+	c.CmdClause = parent.Command("list", "List DigitalOcean Spaces logging endpoints on a Fastly service version")
+
+	c.CmdClause.Flag("service-id", "Service ID").Short('s').StringVar(&c.manifest.Flag.ServiceID)
+	c.CmdClause.Flag("version", "Number of service version").Required().IntVar(&c.Version)
+
+	return &c
+}
+
+// Exec invokes the application logic for the command.
+func (c *ListCommand) Exec(in io.Reader, out io.Writer) error {
+	serviceID, source := c.manifest.ServiceID()
+	if source == manifest.SourceUndefined {
+		return errors.ErrNoServiceID
+	}
+
+	dos, err := c.Globals.Client.ListDigitalOceans(&fastly.ListDigitalOceansInput{
+		Service: serviceID,
+		Version: c.Version,
+	})
+	if err != nil {
+		return err
+	}
+
+	if !c.Globals.Verbose() {
+		tw := text.NewTable(out)
+		tw.AddHeader("SERVICE", "VERSION", "NAME", "BUCKET")
+		for _, do := range dos {
+			tw.AddLine(do.ServiceID, do.Version, do.Name, do.BucketName)
+		}
+		tw.Print()
+		return nil
+	}
+
+	for i, do := range dos {
+		fmt.Fprintf(out, "Service ID: %s\n", do.ServiceID)
+		fmt.Fprintf(out, "Version: %d\n", do.Version)
+		fmt.Fprintf(out, "\tDigitalOcean Spaces %d/%d\n", i+1, len(dos))
+		fmt.Fprintf(out, "\t\tName: %s\n", do.Name)
+		fmt.Fprintf(out, "\t\tBucket: %s\n", do.BucketName)
+		fmt.Fprintf(out, "\t\tDomain: %s\n", do.Domain)
+		fmt.Fprintf(out, "\t\tAccess key: %s\n", do.AccessKey)
+		fmt.Fprintf(out, "\t\tSecret key: %s\n", do.SecretKey)
+		fmt.Fprintf(out, "\t\tPath: %s\n", do.Path)
+		fmt.Fprintf(out, "\t\tPeriod: %d\n", do.Period)
+		fmt.Fprintf(out, "\t\tGZip level: %d\n", do.GzipLevel)
+		fmt.Fprintf(out, "\t\tFormat: %s\n", do.Format)
+		fmt.Fprintf(out, "\t\tFormat version: %d\n", do.FormatVersion)
+		fmt.Fprintf(out, "\t\tResponse condition: %s\n", do.ResponseCondition)
+		fmt.Fprintf(out, "\t\tTimestamp format: %s\n", do.TimestampFormat)
+		fmt.Fprintf(out, "\t\tPlacement: %s\n", do.Placement)
+		fmt.Fprintf(out, "\t\tPublic key: %s\n", do.PublicKey)
+		fmt.Fprintf(out, "\t\tMessage type: %s\n", do.MessageType)
+	}
+	fmt.Fprintln(out)
+
+	return nil
+}
@@ -0,0 +1,49 @@
+package gcs
+
+import (
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+)
+
+// credentialsFile mirrors the fields of a Google Cloud service-account JSON
+// key file that GCS logging cares about: the client_email to authenticate
+// as, and the private_key used to sign requests.
+type credentialsFile struct {
+	Type        string `json:"type"`
+	ClientEmail string `json:"client_email"`
+	PrivateKey  string `json:"private_key"`
+}
+
+// parseCredentials extracts the user (client_email) and secret key
+// (private_key) from a Google Cloud service-account JSON key, sourced from
+// either a literal JSON string or a path to a key file. Exactly one of
+// literal or path must be non-empty.
+func parseCredentials(literal, path string) (user, secretKey string, err error) {
+	var data []byte
+
+	switch {
+	case literal != "" && path != "":
+		return "", "", fmt.Errorf("only one of --credentials-json or --credentials-file may be specified")
+	case literal != "":
+		data = []byte(literal)
+	case path != "":
+		data, err = ioutil.ReadFile(path)
+		if err != nil {
+			return "", "", err
+		}
+	default:
+		return "", "", fmt.Errorf("one of --credentials-json or --credentials-file must be specified")
+	}
+
+	var creds credentialsFile
+	if err := json.Unmarshal(data, &creds); err != nil {
+		return "", "", fmt.Errorf("parsing service account credentials: %w", err)
+	}
+
+	if creds.Type != "service_account" {
+		return "", "", fmt.Errorf("expected a service_account credentials file, got type %q", creds.Type)
+	}
+
+	return creds.ClientEmail, creds.PrivateKey, nil
+}
@@ -1,12 +1,14 @@
 package gcs
 
 import (
+	"fmt"
 	"io"
 
 	"github.com/fastly/cli/pkg/common"
 	"github.com/fastly/cli/pkg/compute/manifest"
 	"github.com/fastly/cli/pkg/config"
 	"github.com/fastly/cli/pkg/errors"
+	"github.com/fastly/cli/pkg/output"
 	"github.com/fastly/cli/pkg/text"
 	"github.com/fastly/go-fastly/fastly"
 )
@@ -25,6 +27,8 @@ type UpdateCommand struct {
 	Bucket            common.OptionalString
 	User              common.OptionalString
 	SecretKey         common.OptionalString
+	CredentialsFile   common.OptionalString
+	CredentialsJSON   common.OptionalString
 	Path              common.OptionalString
 	Period            common.OptionalUint
 	FormatVersion     common.OptionalUint
@@ -34,6 +38,7 @@ type UpdateCommand struct {
 	TimestampFormat   common.OptionalString
 	MessageType       common.OptionalString
 	Placement         common.OptionalString
+	Output            output.Format
 }
 
 // NewUpdateCommand returns a usable command registered under the parent.
@@ -41,6 +46,7 @@ func NewUpdateCommand(parent common.Registerer, globals *config.Data) *UpdateCom
 	var c UpdateCommand
 	c.Globals = globals
 	c.manifest.File.Read(manifest.Filename)
+	c.Output = output.Text
 
 	c.CmdClause = parent.Command("update", "Update a GCS logging endpoint on a Fastly service version")
 
@@ -50,8 +56,10 @@ func NewUpdateCommand(parent common.Registerer, globals *config.Data) *UpdateCom
 
 	c.CmdClause.Flag("new-name", "New name of the GCS logging object").Action(c.NewName.Set).StringVar(&c.NewName.Value)
 	c.CmdClause.Flag("bucket", "The bucket of the GCS bucket").Action(c.Bucket.Set).StringVar(&c.Bucket.Value)
-	c.CmdClause.Flag("user", "Your GCS service account email address. The client_email field in your service account authentication JSON").Action(c.User.Set).StringVar(&c.User.Value)
-	c.CmdClause.Flag("secret-key", "Your GCS account secret key. The private_key field in your service account authentication JSON").Action(c.SecretKey.Set).StringVar(&c.SecretKey.Value)
+	c.CmdClause.Flag("user", "Your GCS service account email address. The client_email field in your service account authentication JSON. Can't be used with --credentials-file or --credentials-json").Action(c.User.Set).StringVar(&c.User.Value)
+	c.CmdClause.Flag("secret-key", "Your GCS account secret key. The private_key field in your service account authentication JSON. Can't be used with --credentials-file or --credentials-json").Action(c.SecretKey.Set).StringVar(&c.SecretKey.Value)
+	c.CmdClause.Flag("credentials-file", "Path to a Google service-account JSON key file. Extracts --user and --secret-key from it; can't be combined with either flag").Action(c.CredentialsFile.Set).StringVar(&c.CredentialsFile.Value)
+	c.CmdClause.Flag("credentials-json", "A Google service-account JSON key, inline. Extracts --user and --secret-key from it; can't be combined with either flag").Action(c.CredentialsJSON.Set).StringVar(&c.CredentialsJSON.Value)
 	c.CmdClause.Flag("path", "The path to upload logs to (default '/')").Action(c.Path.Set).StringVar(&c.Path.Value)
 	c.CmdClause.Flag("period", "How frequently log files are finalized so they can be available for reading (in seconds, default 3600)").Action(c.Period.Set).UintVar(&c.Period.Value)
 	c.CmdClause.Flag("format-version", "The version of the custom logging format used for the configured endpoint. Can be either 2 (the default, version 2 log format) or 1 (the version 1 log format). The logging call gets placed by default in vcl_log if format_version is set to 2 and in vcl_deliver if format_version is set to 1").Action(c.FormatVersion.Set).UintVar(&c.FormatVersion.Value)
@@ -61,6 +69,7 @@ func NewUpdateCommand(parent common.Registerer, globals *config.Data) *UpdateCom
 	c.CmdClause.Flag("timestamp-format", `strftime specified timestamp formatting (default "%Y-%m-%dT%H:%M:%S.000")`).Action(c.TimestampFormat.Set).StringVar(&c.TimestampFormat.Value)
 	c.CmdClause.Flag("message-type", "How the message should be formatted. One of: classic (default), loggly, logplex or blank").Action(c.MessageType.Set).StringVar(&c.MessageType.Value)
 	c.CmdClause.Flag("placement", "Where in the generated VCL the logging call should be placed, overriding any format_version default. Can be none or waf_debug").Action(c.Placement.Set).StringVar(&c.Placement.Value)
+	c.CmdClause.Flag("output", "Render the result as text, json, or yaml").Short('o').Default(string(output.Text)).EnumVar((*string)(&c.Output), string(output.Text), string(output.JSON), string(output.YAML))
 
 	return &c
 }
@@ -72,6 +81,20 @@ func (c *UpdateCommand) createInput() (*fastly.UpdateGCSInput, error) {
 		return nil, errors.ErrNoServiceID
 	}
 
+	if c.CredentialsFile.Valid || c.CredentialsJSON.Valid {
+		if c.User.Valid || c.SecretKey.Valid {
+			return nil, fmt.Errorf("--user and --secret-key can't be used together with --credentials-file or --credentials-json")
+		}
+
+		user, secretKey, err := parseCredentials(c.CredentialsJSON.Value, c.CredentialsFile.Value)
+		if err != nil {
+			return nil, err
+		}
+
+		c.User = common.OptionalString{Valid: true, Value: user}
+		c.SecretKey = common.OptionalString{Valid: true, Value: secretKey}
+	}
+
 	gcs, err := c.Globals.Client.GetGCS(&fastly.GetGCSInput{
 		Service: serviceID,
 		Name:    c.EndpointName,
@@ -168,6 +191,10 @@ func (c *UpdateCommand) Exec(in io.Reader, out io.Writer) error {
 		return err
 	}
 
+	if c.Output != output.Text {
+		return output.Write(out, c.Output, gcs)
+	}
+
 	text.Success(out, "Updated GCS logging endpoint %s (service %s version %d)", gcs.Name, gcs.ServiceID, gcs.Version)
 	return nil
 }
@@ -0,0 +1,74 @@
+package openstack
+
+import (
+	"fmt"
+	"io"
+
+	"github.com/fastly/cli/pkg/common"
+	"github.com/fastly/cli/pkg/compute/manifest"
+	"github.com/fastly/cli/pkg/config"
+	"github.com/fastly/cli/pkg/errors"
+	"github.com/fastly/go-fastly/fastly"
+)
+
+// DescribeCommand calls the Fastly API to describe an OpenStack logging endpoint.
+type DescribeCommand struct {
+	common.Base
+	manifest manifest.Data
+
+	// required
+	EndpointName string // Can't shaddow common.Base method Name().
+	Version      int
+}
+
+// NewDescribeCommand returns a usable command registered under the parent.
+func NewDescribeCommand(parent common.Registerer, globals *config.Data) *DescribeCommand {
+	var c DescribeCommand
+	c.Globals = globals
+	c.manifest.File.Read(manifest.Filename)
+
+	c.CmdClause = parent.Command("describe", "Show detailed information about an OpenStack logging endpoint on a Fastly service version").Alias("get")
+
+	c.CmdClause.Flag("service-id", "Service ID").Short('s').StringVar(&c.manifest.Flag.ServiceID)
+	c.CmdClause.Flag("version", "Number of service version").Required().IntVar(&c.Version)
+	c.CmdClause.Flag("name", "The name of the OpenStack logging object").Short('n').Required().StringVar(&c.EndpointName)
+
+	return &c
+}
+
+// Exec invokes the application logic for the command.
+func (c *DescribeCommand) Exec(in io.Reader, out io.Writer) error {
+	serviceID, source := c.manifest.ServiceID()
+	if source == manifest.SourceUndefined {
+		return errors.ErrNoServiceID
+	}
+
+	o, err := c.Globals.Client.GetOpenstack(&fastly.GetOpenstackInput{
+		Service: serviceID,
+		Name:    c.EndpointName,
+		Version: c.Version,
+	})
+	if err != nil {
+		return err
+	}
+
+	fmt.Fprintf(out, "Service ID: %s\n", o.ServiceID)
+	fmt.Fprintf(out, "Version: %d\n", o.Version)
+	fmt.Fprintf(out, "Name: %s\n", o.Name)
+	fmt.Fprintf(out, "Bucket: %s\n", o.BucketName)
+	fmt.Fprintf(out, "Access key: %s\n", o.AccessKey)
+	fmt.Fprintf(out, "User: %s\n", o.User)
+	fmt.Fprintf(out, "URL: %s\n", o.URL)
+	fmt.Fprintf(out, "Path: %s\n", o.Path)
+	fmt.Fprintf(out, "Period: %d\n", o.Period)
+	fmt.Fprintf(out, "GZip level: %d\n", o.GzipLevel)
+	fmt.Fprintf(out, "Format: %s\n", o.Format)
+	fmt.Fprintf(out, "Format version: %d\n", o.FormatVersion)
+	fmt.Fprintf(out, "Response condition: %s\n", o.ResponseCondition)
+	fmt.Fprintf(out, "Timestamp format: %s\n", o.TimestampFormat)
+	fmt.Fprintf(out, "Placement: %s\n", o.Placement)
+	fmt.Fprintf(out, "Public key: %s\n", o.PublicKey)
+	fmt.Fprintf(out, "Message type: %s\n", o.MessageType)
+
+	return nil
+}
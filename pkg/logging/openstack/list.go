@@ -0,0 +1,84 @@
+package openstack
+
+import (
+	"fmt"
+	"io"
+
+	"github.com/fastly/cli/pkg/common"
+	"github.com/fastly/cli/pkg/compute/manifest"
+	"github.com/fastly/cli/pkg/config"
+	"github.com/fastly/cli/pkg/errors"
+	"github.com/fastly/cli/pkg/text"
+	"github.com/fastly/go-fastly/fastly"
+)
+
+// ListCommand calls the Fastly API to list OpenStack logging endpoints.
+type ListCommand struct {
+	common.Base
+	manifest manifest.Data
+	Version  int
+}
+
+// NewListCommand returns a usable command registered under the parent.
+func NewListCommand(parent common.Registerer, globals *config.Data) *ListCommand {
+	var c ListCommand
+	c.Globals = globals
+	c.manifest.File.Read(manifest.Filename)
+
+	c.CmdClause = parent.Command("list", "List OpenStack logging endpoints on a Fastly service version")
+
+	c.CmdClause.Flag("service-id", "Service ID").Short('s').StringVar(&c.manifest.Flag.ServiceID)
+	c.CmdClause.Flag("version", "Number of service version").Required().IntVar(&c.Version)
+
+	return &c
+}
+
+// Exec invokes the application logic for the command.
+func (c *ListCommand) Exec(in io.Reader, out io.Writer) error {
+	serviceID, source := c.manifest.ServiceID()
+	if source == manifest.SourceUndefined {
+		return errors.ErrNoServiceID
+	}
+
+	os, err := c.Globals.Client.ListOpenstack(&fastly.ListOpenstackInput{
+		Service: serviceID,
+		Version: c.Version,
+	})
+	if err != nil {
+		return err
+	}
+
+	if !c.Globals.Verbose() {
+		tw := text.NewTable(out)
+		tw.AddHeader("SERVICE", "VERSION", "NAME", "BUCKET")
+		for _, o := range os {
+			tw.AddLine(o.ServiceID, o.Version, o.Name, o.BucketName)
+		}
+		tw.Print()
+		return nil
+	}
+
+	for i, o := range os {
+		fmt.Fprintf(out, "Service ID: %s\n", o.ServiceID)
+		fmt.Fprintf(out, "Version: %d\n", o.Version)
+		fmt.Fprintf(out, "\tOpenStack %d/%d\n", i+1, len(os))
+		fmt.Fprintf(out, "\t\tName: %s\n", o.Name)
+		fmt.Fprintf(out, "\t\tBucket: %s\n", o.BucketName)
+		fmt.Fprintf(out, "\t\tAccess key: %s\n", o.AccessKey)
+		fmt.Fprintf(out, "\t\tUser: %s\n", o.User)
+		fmt.Fprintf(out, "\t\tURL: %s\n", o.URL)
+		fmt.Fprintf(out, "\t\tPath: %s\n", o.Path)
+		fmt.Fprintf(out, "\t\tPeriod: %d\n", o.Period)
+		fmt.Fprintf(out, "\t\tGZip level: %d\n", o.GzipLevel)
+		fmt.Fprintf(out, "\t\tFormat: %s\n", o.Format)
+		fmt.Fprintf(out, "\t\tFormat version: %d\n", o.FormatVersion)
+		fmt.Fprintf(out, "\t\tResponse condition: %s\n", o.ResponseCondition)
+		fmt.Fprintf(out, "\t\tTimestamp format: %s\n", o.TimestampFormat)
+		fmt.Fprintf(out, "\t\tPlacement: %s\n", o.Placement)
+		fmt.Fprintf(out, "\t\tPublic key: %s\n", o.PublicKey)
+		fmt.Fprintf(out, "\t\tMessage type: %s\n", o.MessageType)
+	}
+	fmt.Fprintln(out)
+
+	return nil
+}
@@ -0,0 +1,74 @@
+package sftp
+
+import (
+	"fmt"
+	"io"
+
+	"github.com/fastly/cli/pkg/common"
+	"github.com/fastly/cli/pkg/compute/manifest"
+	"github.com/fastly/cli/pkg/config"
+	"github.com/fastly/cli/pkg/errors"
+	"github.com/fastly/go-fastly/fastly"
+)
+
+// DescribeCommand calls the Fastly API to describe an SFTP logging endpoint.
+type DescribeCommand struct {
+	common.Base
+	manifest manifest.Data
+
+	// required
+	EndpointName string // Can't shaddow common.Base method Name().
+	Version      int
+}
+
+// NewDescribeCommand returns a usable command registered under the parent.
+func NewDescribeCommand(parent common.Registerer, globals *config.Data) *DescribeCommand {
+	var c DescribeCommand
+	c.Globals = globals
+	c.manifest.File.Read(manifest.Filename)
+
+	c.CmdClause = parent.Command("describe", "Show detailed information about an SFTP logging endpoint on a Fastly service version").Alias("get")
+
+	c.CmdClause.Flag("service-id", "Service ID").Short('s').StringVar(&c.manifest.Flag.ServiceID)
+	c.CmdClause.Flag("version", "Number of service version").Required().IntVar(&c.Version)
+	c.CmdClause.Flag("name", "The name of the SFTP logging object").Short('n').Required().StringVar(&c.EndpointName)
+
+	return &c
+}
+
+// Exec invokes the application logic for the command.
+func (c *DescribeCommand) Exec(in io.Reader, out io.Writer) error {
+	serviceID, source := c.manifest.ServiceID()
+	if source == manifest.SourceUndefined {
+		return errors.ErrNoServiceID
+	}
+
+	s, err := c.Globals.Client.GetSFTP(&fastly.GetSFTPInput{
+		Service: serviceID,
+		Name:    c.EndpointName,
+		Version: c.Version,
+	})
+	if err != nil {
+		return err
+	}
+
+	fmt.Fprintf(out, "Service ID: %s\n", s.ServiceID)
+	fmt.Fprintf(out, "Version: %d\n", s.Version)
+	fmt.Fprintf(out, "Name: %s\n", s.Name)
+	fmt.Fprintf(out, "Address: %s\n", s.Address)
+	fmt.Fprintf(out, "Port: %d\n", s.Port)
+	fmt.Fprintf(out, "User: %s\n", s.User)
+	fmt.Fprintf(out, "SSH known hosts: %s\n", s.SSHKnownHosts)
+	fmt.Fprintf(out, "Path: %s\n", s.Path)
+	fmt.Fprintf(out, "Period: %d\n", s.Period)
+	fmt.Fprintf(out, "GZip level: %d\n", s.GzipLevel)
+	fmt.Fprintf(out, "Format: %s\n", s.Format)
+	fmt.Fprintf(out, "Format version: %d\n", s.FormatVersion)
+	fmt.Fprintf(out, "Response condition: %s\n", s.ResponseCondition)
+	fmt.Fprintf(out, "Timestamp format: %s\n", s.TimestampFormat)
+	fmt.Fprintf(out, "Placement: %s\n", s.Placement)
+	fmt.Fprintf(out, "Public key: %s\n", s.PublicKey)
+	fmt.Fprintf(out, "Message type: %s\n", s.MessageType)
+
+	return nil
+}
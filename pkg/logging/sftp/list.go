@@ -0,0 +1,84 @@
+package sftp
+
+import (
+	"fmt"
+	"io"
+
+	"github.com/fastly/cli/pkg/common"
+	"github.com/fastly/cli/pkg/compute/manifest"
+	"github.com/fastly/cli/pkg/config"
+	"github.com/fastly/cli/pkg/errors"
+	"github.com/fastly/cli/pkg/text"
+	"github.com/fastly/go-fastly/fastly"
+)
+
+// ListCommand calls the Fastly API to list SFTP logging endpoints.
+type ListCommand struct {
+	common.Base
+	manifest manifest.Data
+	Version  int
+}
+
+// NewListCommand returns a usable command registered under the parent.
+func NewListCommand(parent common.Registerer, globals *config.Data) *ListCommand {
+	var c ListCommand
+	c.Globals = globals
+	c.manifest.File.Read(manifest.Filename)
+
+	c.CmdClause = parent.Command("list", "List SFTP logging endpoints on a Fastly service version")
+
+	c.CmdClause.Flag("service-id", "Service ID").Short('s').StringVar(&c.manifest.Flag.ServiceID)
+	c.CmdClause.Flag("version", "Number of service version").Required().IntVar(&c.Version)
+
+	return &c
+}
+
+// Exec invokes the application logic for the command.
+func (c *ListCommand) Exec(in io.Reader, out io.Writer) error {
+	serviceID, source := c.manifest.ServiceID()
+	if source == manifest.SourceUndefined {
+		return errors.ErrNoServiceID
+	}
+
+	sftps, err := c.Globals.Client.ListSFTPs(&fastly.ListSFTPsInput{
+		Service: serviceID,
+		Version: c.Version,
+	})
+	if err != nil {
+		return err
+	}
+
+	if !c.Globals.Verbose() {
+		tw := text.NewTable(out)
+		tw.AddHeader("SERVICE", "VERSION", "NAME", "ADDRESS")
+		for _, s := range sftps {
+			tw.AddLine(s.ServiceID, s.Version, s.Name, s.Address)
+		}
+		tw.Print()
+		return nil
+	}
+
+	for i, s := range sftps {
+		fmt.Fprintf(out, "Service ID: %s\n", s.ServiceID)
+		fmt.Fprintf(out, "Version: %d\n", s.Version)
+		fmt.Fprintf(out, "\tSFTP %d/%d\n", i+1, len(sftps))
+		fmt.Fprintf(out, "\t\tName: %s\n", s.Name)
+		fmt.Fprintf(out, "\t\tAddress: %s\n", s.Address)
+		fmt.Fprintf(out, "\t\tPort: %d\n", s.Port)
+		fmt.Fprintf(out, "\t\tUser: %s\n", s.User)
+		fmt.Fprintf(out, "\t\tSSH known hosts: %s\n", s.SSHKnownHosts)
+		fmt.Fprintf(out, "\t\tPath: %s\n", s.Path)
+		fmt.Fprintf(out, "\t\tPeriod: %d\n", s.Period)
+		fmt.Fprintf(out, "\t\tGZip level: %d\n", s.GzipLevel)
+		fmt.Fprintf(out, "\t\tFormat: %s\n", s.Format)
+		fmt.Fprintf(out, "\t\tFormat version: %d\n", s.FormatVersion)
+		fmt.Fprintf(out, "\t\tResponse condition: %s\n", s.ResponseCondition)
+		fmt.Fprintf(out, "\t\tTimestamp format: %s\n", s.TimestampFormat)
+		fmt.Fprintf(out, "\t\tPlacement: %s\n", s.Placement)
+		fmt.Fprintf(out, "\t\tPublic key: %s\n", s.PublicKey)
+		fmt.Fprintf(out, "\t\tMessage type: %s\n", s.MessageType)
+	}
+	fmt.Fprintln(out)
+
+	return nil
+}
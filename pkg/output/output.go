@@ -0,0 +1,67 @@
+// Package output provides structured rendering for commands that need to be
+// scriptable, in addition to their human-readable --output text default.
+package output
+
+import (
+	"encoding/json"
+	"fmt"
+	"io"
+
+	yaml "gopkg.in/yaml.v2"
+)
+
+// SchemaVersion is bumped whenever the shape of the Envelope or the records
+// it wraps changes in a backwards-incompatible way.
+const SchemaVersion = 1
+
+// Format is the value of the --output flag.
+type Format string
+
+// Supported output formats.
+const (
+	Text Format = "text"
+	JSON Format = "json"
+	YAML Format = "yaml"
+)
+
+// Valid reports whether f is one of the supported output formats.
+func (f Format) Valid() bool {
+	switch f {
+	case Text, JSON, YAML:
+		return true
+	}
+	return false
+}
+
+// Envelope wraps a structured record with a schema version so that scripted
+// consumers (e.g. `| jq`) have a stable contract to parse against even as
+// the CLI evolves.
+type Envelope struct {
+	SchemaVersion int         `json:"schemaVersion" yaml:"schemaVersion"`
+	Data          interface{} `json:"data" yaml:"data"`
+}
+
+// Write renders data in the requested format to out. format must have
+// already been validated with Valid; Text is treated as a no-op, since
+// callers render their own human-readable output for that case.
+func Write(out io.Writer, format Format, data interface{}) error {
+	envelope := Envelope{SchemaVersion: SchemaVersion, Data: data}
+
+	switch format {
+	case Text:
+		return nil
+	case JSON:
+		enc := json.NewEncoder(out)
+		enc.SetIndent("", "  ")
+		return enc.Encode(envelope)
+	case YAML:
+		b, err := yaml.Marshal(envelope)
+		if err != nil {
+			return err
+		}
+		_, err = out.Write(b)
+		return err
+	default:
+		return fmt.Errorf("unsupported output format %q", format)
+	}
+}
@@ -0,0 +1,1132 @@
+// Package service implements the declarative `fastly service apply` command,
+// which reconciles a service version's logging endpoints against the
+// [logging] tables declared in fastly.toml.
+package service
+
+import (
+	"fmt"
+	"io"
+	"sort"
+
+	"github.com/fastly/cli/pkg/common"
+	"github.com/fastly/cli/pkg/compute/manifest"
+	"github.com/fastly/cli/pkg/config"
+	"github.com/fastly/cli/pkg/errors"
+	"github.com/fastly/cli/pkg/text"
+	"github.com/fastly/go-fastly/fastly"
+)
+
+// action classifies how a named endpoint differs between the manifest and
+// the live service version.
+type action string
+
+const (
+	actionAdd    action = "add"
+	actionChange action = "change"
+	actionRemove action = "remove"
+)
+
+// change describes a single planned API call.
+type change struct {
+	Backend string
+	Name    string
+	Action  action
+}
+
+func (c change) String() string {
+	return fmt.Sprintf("[%s] %s %q", c.Backend, c.Action, c.Name)
+}
+
+// ApplyCommand calls the Fastly API to reconcile a service version's logging
+// endpoints with the declarative configuration in fastly.toml.
+type ApplyCommand struct {
+	common.Base
+	manifest manifest.Data
+
+	// required
+	Version int
+
+	// optional
+	DryRun bool
+}
+
+// NewApplyCommand returns a usable command registered under the parent.
+func NewApplyCommand(parent common.Registerer, globals *config.Data) *ApplyCommand {
+	var c ApplyCommand
+	c.Globals = globals
+	c.manifest.File.Read(manifest.Filename)
+
+	c.CmdClause = parent.Command("apply", "Reconcile a Fastly service version's logging endpoints with the [logging] configuration in fastly.toml")
+
+	c.CmdClause.Flag("service-id", "Service ID").Short('s').StringVar(&c.manifest.Flag.ServiceID)
+	c.CmdClause.Flag("version", "Number of service version to reconcile").Required().IntVar(&c.Version)
+	c.CmdClause.Flag("dry-run", "Print the planned API calls without executing them").BoolVar(&c.DryRun)
+
+	return &c
+}
+
+// Exec invokes the application logic for the command.
+func (c *ApplyCommand) Exec(in io.Reader, out io.Writer) error {
+	serviceID, source := c.manifest.ServiceID()
+	if source == manifest.SourceUndefined {
+		return errors.ErrNoServiceID
+	}
+
+	plan, err := c.plan(serviceID)
+	if err != nil {
+		return err
+	}
+
+	if len(plan) == 0 {
+		text.Success(out, "Service %s version %d already matches fastly.toml, nothing to do", serviceID, c.Version)
+		return nil
+	}
+
+	if c.DryRun {
+		fmt.Fprintf(out, "Planned changes for service %s version %d:\n", serviceID, c.Version)
+		for _, ch := range plan {
+			fmt.Fprintf(out, "  %s\n", ch)
+		}
+		return nil
+	}
+
+	if err := c.apply(serviceID, plan); err != nil {
+		return err
+	}
+
+	text.Success(out, "Applied %d change(s) to service %s version %d", len(plan), serviceID, c.Version)
+	return nil
+}
+
+// plan diffs every backend declared in fastly.toml against the live service
+// version and returns the minimum set of changes needed to converge: an
+// endpoint is only reported as changed when one of the fields fastly.toml
+// declares actually differs from the live endpoint, so a no-op `apply` run
+// plans nothing.
+func (c *ApplyCommand) plan(serviceID string) ([]change, error) {
+	var plan []change
+
+	gcses, err := c.liveGCS(serviceID)
+	if err != nil {
+		return nil, err
+	}
+	plan = append(plan, diffGCS(gcses, c.manifest.File.Logging.GCS)...)
+
+	s3s, err := c.liveS3(serviceID)
+	if err != nil {
+		return nil, err
+	}
+	plan = append(plan, diffS3(s3s, c.manifest.File.Logging.S3)...)
+
+	dos, err := c.liveDigitalOcean(serviceID)
+	if err != nil {
+		return nil, err
+	}
+	plan = append(plan, diffDigitalOcean(dos, c.manifest.File.Logging.DigitalOcean)...)
+
+	oss, err := c.liveOpenstack(serviceID)
+	if err != nil {
+		return nil, err
+	}
+	plan = append(plan, diffOpenstack(oss, c.manifest.File.Logging.Openstack)...)
+
+	azs, err := c.liveAzureBlob(serviceID)
+	if err != nil {
+		return nil, err
+	}
+	plan = append(plan, diffAzureBlob(azs, c.manifest.File.Logging.AzureBlob)...)
+
+	bqs, err := c.liveBigQuery(serviceID)
+	if err != nil {
+		return nil, err
+	}
+	plan = append(plan, diffBigQuery(bqs, c.manifest.File.Logging.BigQuery)...)
+
+	sftps, err := c.liveSFTP(serviceID)
+	if err != nil {
+		return nil, err
+	}
+	plan = append(plan, diffSFTP(sftps, c.manifest.File.Logging.SFTP)...)
+
+	syslogs, err := c.liveSyslog(serviceID)
+	if err != nil {
+		return nil, err
+	}
+	plan = append(plan, diffSyslog(syslogs, c.manifest.File.Logging.Syslog)...)
+
+	sumologics, err := c.liveSumologic(serviceID)
+	if err != nil {
+		return nil, err
+	}
+	plan = append(plan, diffSumologic(sumologics, c.manifest.File.Logging.Sumologic)...)
+
+	return plan, nil
+}
+
+func diffGCS(live map[string]*fastly.GCS, declared []manifest.GCSEndpoint) []change {
+	var changes []change
+	seen := make(map[string]bool, len(declared))
+
+	for _, e := range declared {
+		seen[e.Name] = true
+		l, ok := live[e.Name]
+		if !ok {
+			changes = append(changes, change{Backend: "gcs", Name: e.Name, Action: actionAdd})
+			continue
+		}
+		if l.Bucket != e.Bucket || l.User != e.User || l.SecretKey != e.SecretKey || l.Path != e.Path {
+			changes = append(changes, change{Backend: "gcs", Name: e.Name, Action: actionChange})
+		}
+	}
+
+	var removed []string
+	for name := range live {
+		if !seen[name] {
+			removed = append(removed, name)
+		}
+	}
+	sort.Strings(removed)
+	for _, name := range removed {
+		changes = append(changes, change{Backend: "gcs", Name: name, Action: actionRemove})
+	}
+
+	return changes
+}
+
+func diffS3(live map[string]*fastly.S3, declared []manifest.S3Endpoint) []change {
+	var changes []change
+	seen := make(map[string]bool, len(declared))
+
+	for _, e := range declared {
+		seen[e.Name] = true
+		l, ok := live[e.Name]
+		if !ok {
+			changes = append(changes, change{Backend: "s3", Name: e.Name, Action: actionAdd})
+			continue
+		}
+		if l.BucketName != e.Bucket || l.AccessKey != e.AccessKey || l.SecretKey != e.SecretKey || l.Domain != e.Domain || l.Path != e.Path {
+			changes = append(changes, change{Backend: "s3", Name: e.Name, Action: actionChange})
+		}
+	}
+
+	var removed []string
+	for name := range live {
+		if !seen[name] {
+			removed = append(removed, name)
+		}
+	}
+	sort.Strings(removed)
+	for _, name := range removed {
+		changes = append(changes, change{Backend: "s3", Name: name, Action: actionRemove})
+	}
+
+	return changes
+}
+
+func diffDigitalOcean(live map[string]*fastly.DigitalOcean, declared []manifest.DigitalOceanEndpoint) []change {
+	var changes []change
+	seen := make(map[string]bool, len(declared))
+
+	for _, e := range declared {
+		seen[e.Name] = true
+		l, ok := live[e.Name]
+		if !ok {
+			changes = append(changes, change{Backend: "digitalocean", Name: e.Name, Action: actionAdd})
+			continue
+		}
+		if l.BucketName != e.Bucket || l.AccessKey != e.AccessKey || l.SecretKey != e.SecretKey || l.Domain != e.Domain || l.Path != e.Path {
+			changes = append(changes, change{Backend: "digitalocean", Name: e.Name, Action: actionChange})
+		}
+	}
+
+	var removed []string
+	for name := range live {
+		if !seen[name] {
+			removed = append(removed, name)
+		}
+	}
+	sort.Strings(removed)
+	for _, name := range removed {
+		changes = append(changes, change{Backend: "digitalocean", Name: name, Action: actionRemove})
+	}
+
+	return changes
+}
+
+func diffOpenstack(live map[string]*fastly.Openstack, declared []manifest.OpenstackEndpoint) []change {
+	var changes []change
+	seen := make(map[string]bool, len(declared))
+
+	for _, e := range declared {
+		seen[e.Name] = true
+		l, ok := live[e.Name]
+		if !ok {
+			changes = append(changes, change{Backend: "openstack", Name: e.Name, Action: actionAdd})
+			continue
+		}
+		if l.BucketName != e.Bucket || l.AccessKey != e.AccessKey || l.User != e.User || l.URL != e.URL || l.Path != e.Path {
+			changes = append(changes, change{Backend: "openstack", Name: e.Name, Action: actionChange})
+		}
+	}
+
+	var removed []string
+	for name := range live {
+		if !seen[name] {
+			removed = append(removed, name)
+		}
+	}
+	sort.Strings(removed)
+	for _, name := range removed {
+		changes = append(changes, change{Backend: "openstack", Name: name, Action: actionRemove})
+	}
+
+	return changes
+}
+
+func diffAzureBlob(live map[string]*fastly.BlobStorage, declared []manifest.AzureBlobEndpoint) []change {
+	var changes []change
+	seen := make(map[string]bool, len(declared))
+
+	for _, e := range declared {
+		seen[e.Name] = true
+		l, ok := live[e.Name]
+		if !ok {
+			changes = append(changes, change{Backend: "azureblob", Name: e.Name, Action: actionAdd})
+			continue
+		}
+		if l.Container != e.Container || l.AccountName != e.AccountName || l.SASToken != e.SASToken || l.Path != e.Path {
+			changes = append(changes, change{Backend: "azureblob", Name: e.Name, Action: actionChange})
+		}
+	}
+
+	var removed []string
+	for name := range live {
+		if !seen[name] {
+			removed = append(removed, name)
+		}
+	}
+	sort.Strings(removed)
+	for _, name := range removed {
+		changes = append(changes, change{Backend: "azureblob", Name: name, Action: actionRemove})
+	}
+
+	return changes
+}
+
+func diffBigQuery(live map[string]*fastly.BigQuery, declared []manifest.BigQueryEndpoint) []change {
+	var changes []change
+	seen := make(map[string]bool, len(declared))
+
+	for _, e := range declared {
+		seen[e.Name] = true
+		l, ok := live[e.Name]
+		if !ok {
+			changes = append(changes, change{Backend: "bigquery", Name: e.Name, Action: actionAdd})
+			continue
+		}
+		if l.ProjectID != e.ProjectID || l.Dataset != e.Dataset || l.Table != e.Table || l.User != e.User || l.SecretKey != e.SecretKey || l.TemplateSuffix != e.TemplateSuffix {
+			changes = append(changes, change{Backend: "bigquery", Name: e.Name, Action: actionChange})
+		}
+	}
+
+	var removed []string
+	for name := range live {
+		if !seen[name] {
+			removed = append(removed, name)
+		}
+	}
+	sort.Strings(removed)
+	for _, name := range removed {
+		changes = append(changes, change{Backend: "bigquery", Name: name, Action: actionRemove})
+	}
+
+	return changes
+}
+
+func diffSFTP(live map[string]*fastly.SFTP, declared []manifest.SFTPEndpoint) []change {
+	var changes []change
+	seen := make(map[string]bool, len(declared))
+
+	for _, e := range declared {
+		seen[e.Name] = true
+		l, ok := live[e.Name]
+		if !ok {
+			changes = append(changes, change{Backend: "sftp", Name: e.Name, Action: actionAdd})
+			continue
+		}
+		if l.Address != e.Address || l.Port != e.Port || l.User != e.User || l.Password != e.Password || l.SSHKnownHosts != e.SSHKnownHosts || l.SecretKey != e.SecretKey || l.Path != e.Path {
+			changes = append(changes, change{Backend: "sftp", Name: e.Name, Action: actionChange})
+		}
+	}
+
+	var removed []string
+	for name := range live {
+		if !seen[name] {
+			removed = append(removed, name)
+		}
+	}
+	sort.Strings(removed)
+	for _, name := range removed {
+		changes = append(changes, change{Backend: "sftp", Name: name, Action: actionRemove})
+	}
+
+	return changes
+}
+
+func diffSyslog(live map[string]*fastly.Syslog, declared []manifest.SyslogEndpoint) []change {
+	var changes []change
+	seen := make(map[string]bool, len(declared))
+
+	for _, e := range declared {
+		seen[e.Name] = true
+		l, ok := live[e.Name]
+		if !ok {
+			changes = append(changes, change{Backend: "syslog", Name: e.Name, Action: actionAdd})
+			continue
+		}
+		if l.Address != e.Address || l.Port != e.Port || l.Token != e.Token || l.UseTLS != e.UseTLS {
+			changes = append(changes, change{Backend: "syslog", Name: e.Name, Action: actionChange})
+		}
+	}
+
+	var removed []string
+	for name := range live {
+		if !seen[name] {
+			removed = append(removed, name)
+		}
+	}
+	sort.Strings(removed)
+	for _, name := range removed {
+		changes = append(changes, change{Backend: "syslog", Name: name, Action: actionRemove})
+	}
+
+	return changes
+}
+
+func diffSumologic(live map[string]*fastly.Sumologic, declared []manifest.SumologicEndpoint) []change {
+	var changes []change
+	seen := make(map[string]bool, len(declared))
+
+	for _, e := range declared {
+		seen[e.Name] = true
+		l, ok := live[e.Name]
+		if !ok {
+			changes = append(changes, change{Backend: "sumologic", Name: e.Name, Action: actionAdd})
+			continue
+		}
+		if l.URL != e.URL {
+			changes = append(changes, change{Backend: "sumologic", Name: e.Name, Action: actionChange})
+		}
+	}
+
+	var removed []string
+	for name := range live {
+		if !seen[name] {
+			removed = append(removed, name)
+		}
+	}
+	sort.Strings(removed)
+	for _, name := range removed {
+		changes = append(changes, change{Backend: "sumologic", Name: name, Action: actionRemove})
+	}
+
+	return changes
+}
+
+func (c *ApplyCommand) liveGCS(serviceID string) (map[string]*fastly.GCS, error) {
+	gcses, err := c.Globals.Client.ListGCSs(&fastly.ListGCSsInput{Service: serviceID, Version: c.Version})
+	if err != nil {
+		return nil, err
+	}
+	live := make(map[string]*fastly.GCS, len(gcses))
+	for _, g := range gcses {
+		live[g.Name] = g
+	}
+	return live, nil
+}
+
+func (c *ApplyCommand) liveS3(serviceID string) (map[string]*fastly.S3, error) {
+	s3s, err := c.Globals.Client.ListS3s(&fastly.ListS3sInput{Service: serviceID, Version: c.Version})
+	if err != nil {
+		return nil, err
+	}
+	live := make(map[string]*fastly.S3, len(s3s))
+	for _, s := range s3s {
+		live[s.Name] = s
+	}
+	return live, nil
+}
+
+func (c *ApplyCommand) liveDigitalOcean(serviceID string) (map[string]*fastly.DigitalOcean, error) {
+	dos, err := c.Globals.Client.ListDigitalOceans(&fastly.ListDigitalOceansInput{Service: serviceID, Version: c.Version})
+	if err != nil {
+		return nil, err
+	}
+	live := make(map[string]*fastly.DigitalOcean, len(dos))
+	for _, d := range dos {
+		live[d.Name] = d
+	}
+	return live, nil
+}
+
+func (c *ApplyCommand) liveOpenstack(serviceID string) (map[string]*fastly.Openstack, error) {
+	oss, err := c.Globals.Client.ListOpenstack(&fastly.ListOpenstackInput{Service: serviceID, Version: c.Version})
+	if err != nil {
+		return nil, err
+	}
+	live := make(map[string]*fastly.Openstack, len(oss))
+	for _, o := range oss {
+		live[o.Name] = o
+	}
+	return live, nil
+}
+
+func (c *ApplyCommand) liveAzureBlob(serviceID string) (map[string]*fastly.BlobStorage, error) {
+	abs, err := c.Globals.Client.ListBlobStorages(&fastly.ListBlobStoragesInput{Service: serviceID, Version: c.Version})
+	if err != nil {
+		return nil, err
+	}
+	live := make(map[string]*fastly.BlobStorage, len(abs))
+	for _, a := range abs {
+		live[a.Name] = a
+	}
+	return live, nil
+}
+
+func (c *ApplyCommand) liveBigQuery(serviceID string) (map[string]*fastly.BigQuery, error) {
+	bqs, err := c.Globals.Client.ListBigQueries(&fastly.ListBigQueriesInput{Service: serviceID, Version: c.Version})
+	if err != nil {
+		return nil, err
+	}
+	live := make(map[string]*fastly.BigQuery, len(bqs))
+	for _, b := range bqs {
+		live[b.Name] = b
+	}
+	return live, nil
+}
+
+func (c *ApplyCommand) liveSFTP(serviceID string) (map[string]*fastly.SFTP, error) {
+	sftps, err := c.Globals.Client.ListSFTPs(&fastly.ListSFTPsInput{Service: serviceID, Version: c.Version})
+	if err != nil {
+		return nil, err
+	}
+	live := make(map[string]*fastly.SFTP, len(sftps))
+	for _, s := range sftps {
+		live[s.Name] = s
+	}
+	return live, nil
+}
+
+func (c *ApplyCommand) liveSyslog(serviceID string) (map[string]*fastly.Syslog, error) {
+	syslogs, err := c.Globals.Client.ListSyslogs(&fastly.ListSyslogsInput{Service: serviceID, Version: c.Version})
+	if err != nil {
+		return nil, err
+	}
+	live := make(map[string]*fastly.Syslog, len(syslogs))
+	for _, s := range syslogs {
+		live[s.Name] = s
+	}
+	return live, nil
+}
+
+func (c *ApplyCommand) liveSumologic(serviceID string) (map[string]*fastly.Sumologic, error) {
+	sumologics, err := c.Globals.Client.ListSumologics(&fastly.ListSumologicsInput{Service: serviceID, Version: c.Version})
+	if err != nil {
+		return nil, err
+	}
+	live := make(map[string]*fastly.Sumologic, len(sumologics))
+	for _, s := range sumologics {
+		live[s.Name] = s
+	}
+	return live, nil
+}
+
+// apply issues the Create/Update/Delete calls described by plan. Changes are
+// applied as an overlay onto the live endpoint (mirroring the interactive
+// UpdateCommands' createInput()): only the fields fastly.toml declares are
+// modified, so attributes a request doesn't know about (Period, GzipLevel,
+// Format, and so on) are left untouched rather than reset to zero values.
+func (c *ApplyCommand) apply(serviceID string, plan []change) error {
+	for _, ch := range plan {
+		var err error
+		switch ch.Backend {
+		case "gcs":
+			err = c.applyGCS(serviceID, ch)
+		case "s3":
+			err = c.applyS3(serviceID, ch)
+		case "digitalocean":
+			err = c.applyDigitalOcean(serviceID, ch)
+		case "openstack":
+			err = c.applyOpenstack(serviceID, ch)
+		case "azureblob":
+			err = c.applyAzureBlob(serviceID, ch)
+		case "bigquery":
+			err = c.applyBigQuery(serviceID, ch)
+		case "sftp":
+			err = c.applySFTP(serviceID, ch)
+		case "syslog":
+			err = c.applySyslog(serviceID, ch)
+		case "sumologic":
+			err = c.applySumologic(serviceID, ch)
+		}
+		if err != nil {
+			return fmt.Errorf("%s: %w", ch, err)
+		}
+	}
+
+	return nil
+}
+
+func (c *ApplyCommand) findGCS(name string) *manifest.GCSEndpoint {
+	for i, e := range c.manifest.File.Logging.GCS {
+		if e.Name == name {
+			return &c.manifest.File.Logging.GCS[i]
+		}
+	}
+	return nil
+}
+
+func (c *ApplyCommand) applyGCS(serviceID string, ch change) error {
+	if ch.Action == actionRemove {
+		return c.Globals.Client.DeleteGCS(&fastly.DeleteGCSInput{Service: serviceID, Version: c.Version, Name: ch.Name})
+	}
+
+	e := c.findGCS(ch.Name)
+	if ch.Action == actionAdd {
+		_, err := c.Globals.Client.CreateGCS(&fastly.CreateGCSInput{
+			Service:   serviceID,
+			Version:   c.Version,
+			Name:      e.Name,
+			Bucket:    e.Bucket,
+			User:      e.User,
+			SecretKey: e.SecretKey,
+			Path:      e.Path,
+		})
+		return err
+	}
+
+	live, err := c.Globals.Client.GetGCS(&fastly.GetGCSInput{Service: serviceID, Version: c.Version, Name: ch.Name})
+	if err != nil {
+		return err
+	}
+
+	input := fastly.UpdateGCSInput{
+		Service:           live.ServiceID,
+		Version:           live.Version,
+		Name:              live.Name,
+		NewName:           live.Name,
+		Bucket:            live.Bucket,
+		User:              live.User,
+		SecretKey:         live.SecretKey,
+		Path:              live.Path,
+		Period:            live.Period,
+		FormatVersion:     live.FormatVersion,
+		GzipLevel:         live.GzipLevel,
+		Format:            live.Format,
+		MessageType:       live.MessageType,
+		ResponseCondition: live.ResponseCondition,
+		TimestampFormat:   live.TimestampFormat,
+		Placement:         live.Placement,
+	}
+	input.Bucket = e.Bucket
+	input.User = e.User
+	input.SecretKey = e.SecretKey
+	input.Path = e.Path
+
+	_, err = c.Globals.Client.UpdateGCS(&input)
+	return err
+}
+
+func (c *ApplyCommand) findS3(name string) *manifest.S3Endpoint {
+	for i, e := range c.manifest.File.Logging.S3 {
+		if e.Name == name {
+			return &c.manifest.File.Logging.S3[i]
+		}
+	}
+	return nil
+}
+
+func (c *ApplyCommand) applyS3(serviceID string, ch change) error {
+	if ch.Action == actionRemove {
+		return c.Globals.Client.DeleteS3(&fastly.DeleteS3Input{Service: serviceID, Version: c.Version, Name: ch.Name})
+	}
+
+	e := c.findS3(ch.Name)
+	if ch.Action == actionAdd {
+		_, err := c.Globals.Client.CreateS3(&fastly.CreateS3Input{
+			Service:    serviceID,
+			Version:    c.Version,
+			Name:       e.Name,
+			BucketName: e.Bucket,
+			AccessKey:  e.AccessKey,
+			SecretKey:  e.SecretKey,
+			Domain:     e.Domain,
+			Path:       e.Path,
+		})
+		return err
+	}
+
+	live, err := c.Globals.Client.GetS3(&fastly.GetS3Input{Service: serviceID, Version: c.Version, Name: ch.Name})
+	if err != nil {
+		return err
+	}
+
+	input := fastly.UpdateS3Input{
+		Service:           live.ServiceID,
+		Version:           live.Version,
+		Name:              live.Name,
+		NewName:           live.Name,
+		BucketName:        live.BucketName,
+		AccessKey:         live.AccessKey,
+		SecretKey:         live.SecretKey,
+		Domain:            live.Domain,
+		Path:              live.Path,
+		Period:            live.Period,
+		GzipLevel:         live.GzipLevel,
+		Format:            live.Format,
+		FormatVersion:     live.FormatVersion,
+		ResponseCondition: live.ResponseCondition,
+		TimestampFormat:   live.TimestampFormat,
+		Redundancy:        live.Redundancy,
+		Placement:         live.Placement,
+		PublicKey:         live.PublicKey,
+		MessageType:       live.MessageType,
+	}
+	input.BucketName = e.Bucket
+	input.AccessKey = e.AccessKey
+	input.SecretKey = e.SecretKey
+	input.Domain = e.Domain
+	input.Path = e.Path
+
+	_, err = c.Globals.Client.UpdateS3(&input)
+	return err
+}
+
+func (c *ApplyCommand) findDigitalOcean(name string) *manifest.DigitalOceanEndpoint {
+	for i, e := range c.manifest.File.Logging.DigitalOcean {
+		if e.Name == name {
+			return &c.manifest.File.Logging.DigitalOcean[i]
+		}
+	}
+	return nil
+}
+
+func (c *ApplyCommand) applyDigitalOcean(serviceID string, ch change) error {
+	if ch.Action == actionRemove {
+		return c.Globals.Client.DeleteDigitalOcean(&fastly.DeleteDigitalOceanInput{Service: serviceID, Version: c.Version, Name: ch.Name})
+	}
+
+	e := c.findDigitalOcean(ch.Name)
+	if ch.Action == actionAdd {
+		_, err := c.Globals.Client.CreateDigitalOcean(&fastly.CreateDigitalOceanInput{
+			Service:    serviceID,
+			Version:    c.Version,
+			Name:       e.Name,
+			BucketName: e.Bucket,
+			AccessKey:  e.AccessKey,
+			SecretKey:  e.SecretKey,
+			Domain:     e.Domain,
+			Path:       e.Path,
+		})
+		return err
+	}
+
+	live, err := c.Globals.Client.GetDigitalOcean(&fastly.GetDigitalOceanInput{Service: serviceID, Version: c.Version, Name: ch.Name})
+	if err != nil {
+		return err
+	}
+
+	input := fastly.UpdateDigitalOceanInput{
+		Service:           live.ServiceID,
+		Version:           live.Version,
+		Name:              live.Name,
+		NewName:           live.Name,
+		BucketName:        live.BucketName,
+		AccessKey:         live.AccessKey,
+		SecretKey:         live.SecretKey,
+		Domain:            live.Domain,
+		Path:              live.Path,
+		Period:            live.Period,
+		GzipLevel:         live.GzipLevel,
+		Format:            live.Format,
+		FormatVersion:     live.FormatVersion,
+		ResponseCondition: live.ResponseCondition,
+		TimestampFormat:   live.TimestampFormat,
+		Placement:         live.Placement,
+		PublicKey:         live.PublicKey,
+		MessageType:       live.MessageType,
+	}
+	input.BucketName = e.Bucket
+	input.AccessKey = e.AccessKey
+	input.SecretKey = e.SecretKey
+	input.Domain = e.Domain
+	input.Path = e.Path
+
+	_, err = c.Globals.Client.UpdateDigitalOcean(&input)
+	return err
+}
+
+func (c *ApplyCommand) findOpenstack(name string) *manifest.OpenstackEndpoint {
+	for i, e := range c.manifest.File.Logging.Openstack {
+		if e.Name == name {
+			return &c.manifest.File.Logging.Openstack[i]
+		}
+	}
+	return nil
+}
+
+func (c *ApplyCommand) applyOpenstack(serviceID string, ch change) error {
+	if ch.Action == actionRemove {
+		return c.Globals.Client.DeleteOpenstack(&fastly.DeleteOpenstackInput{Service: serviceID, Version: c.Version, Name: ch.Name})
+	}
+
+	e := c.findOpenstack(ch.Name)
+	if ch.Action == actionAdd {
+		_, err := c.Globals.Client.CreateOpenstack(&fastly.CreateOpenstackInput{
+			Service:    serviceID,
+			Version:    c.Version,
+			Name:       e.Name,
+			BucketName: e.Bucket,
+			AccessKey:  e.AccessKey,
+			User:       e.User,
+			URL:        e.URL,
+			Path:       e.Path,
+		})
+		return err
+	}
+
+	live, err := c.Globals.Client.GetOpenstack(&fastly.GetOpenstackInput{Service: serviceID, Version: c.Version, Name: ch.Name})
+	if err != nil {
+		return err
+	}
+
+	input := fastly.UpdateOpenstackInput{
+		Service:           live.ServiceID,
+		Version:           live.Version,
+		Name:              live.Name,
+		NewName:           live.Name,
+		BucketName:        live.BucketName,
+		AccessKey:         live.AccessKey,
+		User:              live.User,
+		URL:               live.URL,
+		Path:              live.Path,
+		Period:            live.Period,
+		GzipLevel:         live.GzipLevel,
+		Format:            live.Format,
+		FormatVersion:     live.FormatVersion,
+		ResponseCondition: live.ResponseCondition,
+		TimestampFormat:   live.TimestampFormat,
+		Placement:         live.Placement,
+		PublicKey:         live.PublicKey,
+		MessageType:       live.MessageType,
+	}
+	input.BucketName = e.Bucket
+	input.AccessKey = e.AccessKey
+	input.User = e.User
+	input.URL = e.URL
+	input.Path = e.Path
+
+	_, err = c.Globals.Client.UpdateOpenstack(&input)
+	return err
+}
+
+func (c *ApplyCommand) findAzureBlob(name string) *manifest.AzureBlobEndpoint {
+	for i, e := range c.manifest.File.Logging.AzureBlob {
+		if e.Name == name {
+			return &c.manifest.File.Logging.AzureBlob[i]
+		}
+	}
+	return nil
+}
+
+func (c *ApplyCommand) applyAzureBlob(serviceID string, ch change) error {
+	if ch.Action == actionRemove {
+		return c.Globals.Client.DeleteBlobStorage(&fastly.DeleteBlobStorageInput{Service: serviceID, Version: c.Version, Name: ch.Name})
+	}
+
+	e := c.findAzureBlob(ch.Name)
+	if ch.Action == actionAdd {
+		_, err := c.Globals.Client.CreateBlobStorage(&fastly.CreateBlobStorageInput{
+			Service:     serviceID,
+			Version:     c.Version,
+			Name:        e.Name,
+			Container:   e.Container,
+			AccountName: e.AccountName,
+			SASToken:    e.SASToken,
+			Path:        e.Path,
+		})
+		return err
+	}
+
+	live, err := c.Globals.Client.GetBlobStorage(&fastly.GetBlobStorageInput{Service: serviceID, Version: c.Version, Name: ch.Name})
+	if err != nil {
+		return err
+	}
+
+	input := fastly.UpdateBlobStorageInput{
+		Service:           live.ServiceID,
+		Version:           live.Version,
+		Name:              live.Name,
+		NewName:           live.Name,
+		Container:         live.Container,
+		AccountName:       live.AccountName,
+		SASToken:          live.SASToken,
+		Path:              live.Path,
+		Period:            live.Period,
+		GzipLevel:         live.GzipLevel,
+		Format:            live.Format,
+		FormatVersion:     live.FormatVersion,
+		ResponseCondition: live.ResponseCondition,
+		TimestampFormat:   live.TimestampFormat,
+		Placement:         live.Placement,
+		PublicKey:         live.PublicKey,
+		MessageType:       live.MessageType,
+	}
+	input.Container = e.Container
+	input.AccountName = e.AccountName
+	input.SASToken = e.SASToken
+	input.Path = e.Path
+
+	_, err = c.Globals.Client.UpdateBlobStorage(&input)
+	return err
+}
+
+func (c *ApplyCommand) findBigQuery(name string) *manifest.BigQueryEndpoint {
+	for i, e := range c.manifest.File.Logging.BigQuery {
+		if e.Name == name {
+			return &c.manifest.File.Logging.BigQuery[i]
+		}
+	}
+	return nil
+}
+
+func (c *ApplyCommand) applyBigQuery(serviceID string, ch change) error {
+	if ch.Action == actionRemove {
+		return c.Globals.Client.DeleteBigQuery(&fastly.DeleteBigQueryInput{Service: serviceID, Version: c.Version, Name: ch.Name})
+	}
+
+	e := c.findBigQuery(ch.Name)
+	if ch.Action == actionAdd {
+		_, err := c.Globals.Client.CreateBigQuery(&fastly.CreateBigQueryInput{
+			Service:        serviceID,
+			Version:        c.Version,
+			Name:           e.Name,
+			ProjectID:      e.ProjectID,
+			Dataset:        e.Dataset,
+			Table:          e.Table,
+			User:           e.User,
+			SecretKey:      e.SecretKey,
+			TemplateSuffix: e.TemplateSuffix,
+		})
+		return err
+	}
+
+	live, err := c.Globals.Client.GetBigQuery(&fastly.GetBigQueryInput{Service: serviceID, Version: c.Version, Name: ch.Name})
+	if err != nil {
+		return err
+	}
+
+	input := fastly.UpdateBigQueryInput{
+		Service:           live.ServiceID,
+		Version:           live.Version,
+		Name:              live.Name,
+		NewName:           live.Name,
+		ProjectID:         live.ProjectID,
+		Dataset:           live.Dataset,
+		Table:             live.Table,
+		User:              live.User,
+		SecretKey:         live.SecretKey,
+		TemplateSuffix:    live.TemplateSuffix,
+		Format:            live.Format,
+		ResponseCondition: live.ResponseCondition,
+		Placement:         live.Placement,
+	}
+	input.ProjectID = e.ProjectID
+	input.Dataset = e.Dataset
+	input.Table = e.Table
+	input.User = e.User
+	input.SecretKey = e.SecretKey
+	input.TemplateSuffix = e.TemplateSuffix
+
+	_, err = c.Globals.Client.UpdateBigQuery(&input)
+	return err
+}
+
+func (c *ApplyCommand) findSFTP(name string) *manifest.SFTPEndpoint {
+	for i, e := range c.manifest.File.Logging.SFTP {
+		if e.Name == name {
+			return &c.manifest.File.Logging.SFTP[i]
+		}
+	}
+	return nil
+}
+
+func (c *ApplyCommand) applySFTP(serviceID string, ch change) error {
+	if ch.Action == actionRemove {
+		return c.Globals.Client.DeleteSFTP(&fastly.DeleteSFTPInput{Service: serviceID, Version: c.Version, Name: ch.Name})
+	}
+
+	e := c.findSFTP(ch.Name)
+	if ch.Action == actionAdd {
+		_, err := c.Globals.Client.CreateSFTP(&fastly.CreateSFTPInput{
+			Service:       serviceID,
+			Version:       c.Version,
+			Name:          e.Name,
+			Address:       e.Address,
+			Port:          e.Port,
+			User:          e.User,
+			Password:      e.Password,
+			SSHKnownHosts: e.SSHKnownHosts,
+			SecretKey:     e.SecretKey,
+			Path:          e.Path,
+		})
+		return err
+	}
+
+	live, err := c.Globals.Client.GetSFTP(&fastly.GetSFTPInput{Service: serviceID, Version: c.Version, Name: ch.Name})
+	if err != nil {
+		return err
+	}
+
+	input := fastly.UpdateSFTPInput{
+		Service:           live.ServiceID,
+		Version:           live.Version,
+		Name:              live.Name,
+		NewName:           live.Name,
+		Address:           live.Address,
+		Port:              live.Port,
+		User:              live.User,
+		Password:          live.Password,
+		SSHKnownHosts:     live.SSHKnownHosts,
+		SecretKey:         live.SecretKey,
+		PublicKey:         live.PublicKey,
+		Path:              live.Path,
+		Period:            live.Period,
+		GzipLevel:         live.GzipLevel,
+		Format:            live.Format,
+		FormatVersion:     live.FormatVersion,
+		ResponseCondition: live.ResponseCondition,
+		TimestampFormat:   live.TimestampFormat,
+		Placement:         live.Placement,
+		MessageType:       live.MessageType,
+	}
+	input.Address = e.Address
+	input.Port = e.Port
+	input.User = e.User
+	input.Password = e.Password
+	input.SSHKnownHosts = e.SSHKnownHosts
+	input.SecretKey = e.SecretKey
+	input.Path = e.Path
+
+	_, err = c.Globals.Client.UpdateSFTP(&input)
+	return err
+}
+
+func (c *ApplyCommand) findSyslog(name string) *manifest.SyslogEndpoint {
+	for i, e := range c.manifest.File.Logging.Syslog {
+		if e.Name == name {
+			return &c.manifest.File.Logging.Syslog[i]
+		}
+	}
+	return nil
+}
+
+func (c *ApplyCommand) applySyslog(serviceID string, ch change) error {
+	if ch.Action == actionRemove {
+		return c.Globals.Client.DeleteSyslog(&fastly.DeleteSyslogInput{Service: serviceID, Version: c.Version, Name: ch.Name})
+	}
+
+	e := c.findSyslog(ch.Name)
+	if ch.Action == actionAdd {
+		_, err := c.Globals.Client.CreateSyslog(&fastly.CreateSyslogInput{
+			Service: serviceID,
+			Version: c.Version,
+			Name:    e.Name,
+			Address: e.Address,
+			Port:    e.Port,
+			Token:   e.Token,
+			UseTLS:  e.UseTLS,
+		})
+		return err
+	}
+
+	live, err := c.Globals.Client.GetSyslog(&fastly.GetSyslogInput{Service: serviceID, Version: c.Version, Name: ch.Name})
+	if err != nil {
+		return err
+	}
+
+	input := fastly.UpdateSyslogInput{
+		Service:           live.ServiceID,
+		Version:           live.Version,
+		Name:              live.Name,
+		NewName:           live.Name,
+		Address:           live.Address,
+		Port:              live.Port,
+		Token:             live.Token,
+		UseTLS:            live.UseTLS,
+		TLSCACert:         live.TLSCACert,
+		TLSClientCert:     live.TLSClientCert,
+		TLSClientKey:      live.TLSClientKey,
+		TLSHostname:       live.TLSHostname,
+		Format:            live.Format,
+		FormatVersion:     live.FormatVersion,
+		ResponseCondition: live.ResponseCondition,
+		MessageType:       live.MessageType,
+		Placement:         live.Placement,
+	}
+	input.Address = e.Address
+	input.Port = e.Port
+	input.Token = e.Token
+	input.UseTLS = e.UseTLS
+
+	_, err = c.Globals.Client.UpdateSyslog(&input)
+	return err
+}
+
+func (c *ApplyCommand) findSumologic(name string) *manifest.SumologicEndpoint {
+	for i, e := range c.manifest.File.Logging.Sumologic {
+		if e.Name == name {
+			return &c.manifest.File.Logging.Sumologic[i]
+		}
+	}
+	return nil
+}
+
+func (c *ApplyCommand) applySumologic(serviceID string, ch change) error {
+	if ch.Action == actionRemove {
+		return c.Globals.Client.DeleteSumologic(&fastly.DeleteSumologicInput{Service: serviceID, Version: c.Version, Name: ch.Name})
+	}
+
+	e := c.findSumologic(ch.Name)
+	if ch.Action == actionAdd {
+		_, err := c.Globals.Client.CreateSumologic(&fastly.CreateSumologicInput{
+			Service: serviceID,
+			Version: c.Version,
+			Name:    e.Name,
+			URL:     e.URL,
+		})
+		return err
+	}
+
+	live, err := c.Globals.Client.GetSumologic(&fastly.GetSumologicInput{Service: serviceID, Version: c.Version, Name: ch.Name})
+	if err != nil {
+		return err
+	}
+
+	input := fastly.UpdateSumologicInput{
+		Service:           live.ServiceID,
+		Version:           live.Version,
+		Name:              live.Name,
+		NewName:           live.Name,
+		URL:               live.URL,
+		Format:            live.Format,
+		FormatVersion:     live.FormatVersion,
+		ResponseCondition: live.ResponseCondition,
+		MessageType:       live.MessageType,
+		Placement:         live.Placement,
+	}
+	input.URL = e.URL
+
+	_, err = c.Globals.Client.UpdateSumologic(&input)
+	return err
+}
@@ -0,0 +1,38 @@
+// Package vcl provides shared helpers for working with custom VCL content,
+// used by the pkg/vcl/custom and pkg/vcl/snippet command families.
+package vcl
+
+import (
+	"crypto/sha1"
+	"encoding/hex"
+	"fmt"
+	"io/ioutil"
+)
+
+// ResolveContent returns the VCL content to send to the API, sourced from
+// either a literal --content flag or a --content-file path. Exactly one of
+// content or contentFile must be non-empty.
+func ResolveContent(content, contentFile string) (string, error) {
+	switch {
+	case content != "" && contentFile != "":
+		return "", fmt.Errorf("only one of --content or --content-file may be specified")
+	case content != "":
+		return content, nil
+	case contentFile != "":
+		b, err := ioutil.ReadFile(contentFile)
+		if err != nil {
+			return "", err
+		}
+		return string(b), nil
+	default:
+		return "", fmt.Errorf("one of --content or --content-file must be specified")
+	}
+}
+
+// HashContent returns a hex-encoded SHA-1 digest of content, used to detect
+// whether the local VCL differs from what's already configured on the
+// service version so updates can be skipped when nothing has changed.
+func HashContent(content string) string {
+	sum := sha1.Sum([]byte(content))
+	return hex.EncodeToString(sum[:])
+}
@@ -0,0 +1,83 @@
+package custom
+
+import (
+	"io"
+
+	"github.com/fastly/cli/pkg/common"
+	"github.com/fastly/cli/pkg/compute/manifest"
+	"github.com/fastly/cli/pkg/config"
+	"github.com/fastly/cli/pkg/errors"
+	"github.com/fastly/cli/pkg/text"
+	"github.com/fastly/cli/pkg/vcl"
+	"github.com/fastly/go-fastly/fastly"
+)
+
+// CreateCommand calls the Fastly API to create custom VCL on a service version.
+type CreateCommand struct {
+	common.Base
+	manifest manifest.Data
+
+	// required
+	Name        string
+	Version     int
+	Content     string
+	ContentFile string
+
+	// optional
+	Main bool
+}
+
+// NewCreateCommand returns a usable command registered under the parent.
+func NewCreateCommand(parent common.Registerer, globals *config.Data) *CreateCommand {
+	var c CreateCommand
+	c.Globals = globals
+	c.manifest.File.Read(manifest.Filename)
+
+	c.CmdClause = parent.Command("create", "Upload a custom VCL file to a Fastly service version")
+
+	c.CmdClause.Flag("service-id", "Service ID").Short('s').StringVar(&c.manifest.Flag.ServiceID)
+	c.CmdClause.Flag("version", "Number of service version").Required().IntVar(&c.Version)
+	c.CmdClause.Flag("name", "The name of the VCL file").Short('n').Required().StringVar(&c.Name)
+	c.CmdClause.Flag("content", "The VCL content").StringVar(&c.Content)
+	c.CmdClause.Flag("content-file", "Path to a file containing the VCL content").StringVar(&c.ContentFile)
+	c.CmdClause.Flag("main", "Whether this is the main VCL, of which only one per service version is allowed").BoolVar(&c.Main)
+
+	return &c
+}
+
+// createInput transforms values parsed from CLI flags into an object to be used by the API client library.
+func (c *CreateCommand) createInput() (*fastly.CreateVCLInput, error) {
+	serviceID, source := c.manifest.ServiceID()
+	if source == manifest.SourceUndefined {
+		return nil, errors.ErrNoServiceID
+	}
+
+	content, err := vcl.ResolveContent(c.Content, c.ContentFile)
+	if err != nil {
+		return nil, err
+	}
+
+	return &fastly.CreateVCLInput{
+		Service: serviceID,
+		Version: c.Version,
+		Name:    c.Name,
+		Content: content,
+		Main:    c.Main,
+	}, nil
+}
+
+// Exec invokes the application logic for the command.
+func (c *CreateCommand) Exec(in io.Reader, out io.Writer) error {
+	input, err := c.createInput()
+	if err != nil {
+		return err
+	}
+
+	v, err := c.Globals.Client.CreateVCL(input)
+	if err != nil {
+		return err
+	}
+
+	text.Success(out, "Created custom VCL %s (service %s version %d)", v.Name, v.ServiceID, v.Version)
+	return nil
+}
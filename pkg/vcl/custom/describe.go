@@ -0,0 +1,64 @@
+package custom
+
+import (
+	"fmt"
+	"io"
+
+	"github.com/fastly/cli/pkg/common"
+	"github.com/fastly/cli/pkg/compute/manifest"
+	"github.com/fastly/cli/pkg/config"
+	"github.com/fastly/cli/pkg/errors"
+	"github.com/fastly/cli/pkg/vcl"
+	"github.com/fastly/go-fastly/fastly"
+)
+
+// DescribeCommand calls the Fastly API to describe a custom VCL file.
+type DescribeCommand struct {
+	common.Base
+	manifest manifest.Data
+
+	// required
+	Name    string
+	Version int
+}
+
+// NewDescribeCommand returns a usable command registered under the parent.
+func NewDescribeCommand(parent common.Registerer, globals *config.Data) *DescribeCommand {
+	var c DescribeCommand
+	c.Globals = globals
+	c.manifest.File.Read(manifest.Filename)
+
+	c.CmdClause = parent.Command("describe", "Show detailed information about a custom VCL file on a Fastly service version").Alias("get")
+
+	c.CmdClause.Flag("service-id", "Service ID").Short('s').StringVar(&c.manifest.Flag.ServiceID)
+	c.CmdClause.Flag("version", "Number of service version").Required().IntVar(&c.Version)
+	c.CmdClause.Flag("name", "The name of the VCL file").Short('n').Required().StringVar(&c.Name)
+
+	return &c
+}
+
+// Exec invokes the application logic for the command.
+func (c *DescribeCommand) Exec(in io.Reader, out io.Writer) error {
+	serviceID, source := c.manifest.ServiceID()
+	if source == manifest.SourceUndefined {
+		return errors.ErrNoServiceID
+	}
+
+	v, err := c.Globals.Client.GetVCL(&fastly.GetVCLInput{
+		Service: serviceID,
+		Name:    c.Name,
+		Version: c.Version,
+	})
+	if err != nil {
+		return err
+	}
+
+	fmt.Fprintf(out, "Service ID: %s\n", v.ServiceID)
+	fmt.Fprintf(out, "Version: %d\n", v.Version)
+	fmt.Fprintf(out, "Name: %s\n", v.Name)
+	fmt.Fprintf(out, "Main: %t\n", v.Main)
+	fmt.Fprintf(out, "Content SHA1: %s\n", vcl.HashContent(v.Content))
+	fmt.Fprintf(out, "Content:\n%s\n", v.Content)
+
+	return nil
+}
@@ -0,0 +1,114 @@
+package custom
+
+import (
+	"io"
+
+	"github.com/fastly/cli/pkg/common"
+	"github.com/fastly/cli/pkg/compute/manifest"
+	"github.com/fastly/cli/pkg/config"
+	"github.com/fastly/cli/pkg/errors"
+	"github.com/fastly/cli/pkg/text"
+	"github.com/fastly/cli/pkg/vcl"
+	"github.com/fastly/go-fastly/fastly"
+)
+
+// UpdateCommand calls the Fastly API to update custom VCL on a service version.
+type UpdateCommand struct {
+	common.Base
+	manifest manifest.Data
+
+	// required
+	Name    string
+	Version int
+
+	// optional
+	Content     common.OptionalString
+	ContentFile common.OptionalString
+	Main        common.OptionalBool
+}
+
+// NewUpdateCommand returns a usable command registered under the parent.
+func NewUpdateCommand(parent common.Registerer, globals *config.Data) *UpdateCommand {
+	var c UpdateCommand
+	c.Globals = globals
+	c.manifest.File.Read(manifest.Filename)
+
+	c.CmdClause = parent.Command("update", "Update a custom VCL file on a Fastly service version")
+
+	c.CmdClause.Flag("service-id", "Service ID").Short('s').StringVar(&c.manifest.Flag.ServiceID)
+	c.CmdClause.Flag("version", "Number of service version").Required().IntVar(&c.Version)
+	c.CmdClause.Flag("name", "The name of the VCL file to update").Short('n').Required().StringVar(&c.Name)
+
+	c.CmdClause.Flag("content", "The VCL content").Action(c.Content.Set).StringVar(&c.Content.Value)
+	c.CmdClause.Flag("content-file", "Path to a file containing the VCL content").Action(c.ContentFile.Set).StringVar(&c.ContentFile.Value)
+	c.CmdClause.Flag("main", "Whether this is the main VCL, of which only one per service version is allowed").Action(c.Main.Set).BoolVar(&c.Main.Value)
+
+	return &c
+}
+
+// createInput transforms values parsed from CLI flags into an object to be used by the API client library.
+// It returns a nil input (and no error) when the computed content hash
+// matches what's already on the service version and the main flag is
+// unchanged, so the caller can skip the PUT entirely.
+func (c *UpdateCommand) createInput() (*fastly.UpdateVCLInput, error) {
+	serviceID, source := c.manifest.ServiceID()
+	if source == manifest.SourceUndefined {
+		return nil, errors.ErrNoServiceID
+	}
+
+	v, err := c.Globals.Client.GetVCL(&fastly.GetVCLInput{
+		Service: serviceID,
+		Name:    c.Name,
+		Version: c.Version,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	input := fastly.UpdateVCLInput{
+		Service: v.ServiceID,
+		Version: v.Version,
+		Name:    v.Name,
+		Content: v.Content,
+		Main:    v.Main,
+	}
+
+	if c.Content.Valid || c.ContentFile.Valid {
+		content, err := vcl.ResolveContent(c.Content.Value, c.ContentFile.Value)
+		if err != nil {
+			return nil, err
+		}
+		input.Content = content
+	}
+
+	if c.Main.Valid {
+		input.Main = c.Main.Value
+	}
+
+	if vcl.HashContent(input.Content) == vcl.HashContent(v.Content) && input.Main == v.Main {
+		return nil, nil
+	}
+
+	return &input, nil
+}
+
+// Exec invokes the application logic for the command.
+func (c *UpdateCommand) Exec(in io.Reader, out io.Writer) error {
+	input, err := c.createInput()
+	if err != nil {
+		return err
+	}
+
+	if input == nil {
+		text.Success(out, "VCL %s is already up to date, skipping update", c.Name)
+		return nil
+	}
+
+	v, err := c.Globals.Client.UpdateVCL(input)
+	if err != nil {
+		return err
+	}
+
+	text.Success(out, "Updated custom VCL %s (service %s version %d)", v.Name, v.ServiceID, v.Version)
+	return nil
+}
@@ -0,0 +1,97 @@
+package snippet
+
+import (
+	"io"
+
+	"github.com/fastly/cli/pkg/common"
+	"github.com/fastly/cli/pkg/compute/manifest"
+	"github.com/fastly/cli/pkg/config"
+	"github.com/fastly/cli/pkg/errors"
+	"github.com/fastly/cli/pkg/text"
+	"github.com/fastly/cli/pkg/vcl"
+	"github.com/fastly/go-fastly/fastly"
+)
+
+// CreateCommand calls the Fastly API to create a VCL snippet on a service version.
+type CreateCommand struct {
+	common.Base
+	manifest manifest.Data
+
+	// required
+	Name        string
+	Version     int
+	Type        string
+	Content     string
+	ContentFile string
+
+	// optional
+	Priority common.OptionalInt
+	Dynamic  bool
+}
+
+// NewCreateCommand returns a usable command registered under the parent.
+func NewCreateCommand(parent common.Registerer, globals *config.Data) *CreateCommand {
+	var c CreateCommand
+	c.Globals = globals
+	c.manifest.File.Read(manifest.Filename)
+
+	c.CmdClause = parent.Command("create", "Upload a VCL snippet to a Fastly service version")
+
+	c.CmdClause.Flag("service-id", "Service ID").Short('s').StringVar(&c.manifest.Flag.ServiceID)
+	c.CmdClause.Flag("version", "Number of service version").Required().IntVar(&c.Version)
+	c.CmdClause.Flag("name", "The name of the VCL snippet").Short('n').Required().StringVar(&c.Name)
+	c.CmdClause.Flag("type", "The location in generated VCL where the snippet should be inserted. Can be: init, recv, hit, miss, pass, fetch, error, deliver, log, none").Required().StringVar(&c.Type)
+	c.CmdClause.Flag("content", "The VCL snippet content").StringVar(&c.Content)
+	c.CmdClause.Flag("content-file", "Path to a file containing the VCL snippet content").StringVar(&c.ContentFile)
+	c.CmdClause.Flag("priority", "Priority determines execution order of multiple snippets of the same type (lower runs first, default 100)").Action(c.Priority.Set).IntVar(&c.Priority.Value)
+	c.CmdClause.Flag("dynamic", "Whether the snippet can be edited without requiring a new service version activation").BoolVar(&c.Dynamic)
+
+	return &c
+}
+
+// createInput transforms values parsed from CLI flags into an object to be used by the API client library.
+func (c *CreateCommand) createInput() (*fastly.CreateSnippetInput, error) {
+	serviceID, source := c.manifest.ServiceID()
+	if source == manifest.SourceUndefined {
+		return nil, errors.ErrNoServiceID
+	}
+
+	content, err := vcl.ResolveContent(c.Content, c.ContentFile)
+	if err != nil {
+		return nil, err
+	}
+
+	input := fastly.CreateSnippetInput{
+		Service: serviceID,
+		Version: c.Version,
+		Name:    c.Name,
+		Type:    fastly.SnippetType(c.Type),
+		Content: content,
+	}
+
+	if c.Priority.Valid {
+		input.Priority = c.Priority.Value
+	}
+
+	if c.Dynamic {
+		input.Dynamic = 1
+	}
+
+	return &input, nil
+}
+
+// Exec invokes the application logic for the command.
+func (c *CreateCommand) Exec(in io.Reader, out io.Writer) error {
+	input, err := c.createInput()
+	if err != nil {
+		return err
+	}
+
+	s, err := c.Globals.Client.CreateSnippet(input)
+	if err != nil {
+		return err
+	}
+
+	text.Success(out, "Created VCL snippet %s (service %s version %d)", s.Name, s.ServiceID, s.Version)
+	return nil
+}
@@ -0,0 +1,57 @@
+package snippet
+
+import (
+	"io"
+
+	"github.com/fastly/cli/pkg/common"
+	"github.com/fastly/cli/pkg/compute/manifest"
+	"github.com/fastly/cli/pkg/config"
+	"github.com/fastly/cli/pkg/errors"
+	"github.com/fastly/cli/pkg/text"
+	"github.com/fastly/go-fastly/fastly"
+)
+
+// DeleteCommand calls the Fastly API to delete a VCL snippet from a service version.
+type DeleteCommand struct {
+	common.Base
+	manifest manifest.Data
+
+	// required
+	Name    string
+	Version int
+}
+
+// NewDeleteCommand returns a usable command registered under the parent.
+func NewDeleteCommand(parent common.Registerer, globals *config.Data) *DeleteCommand {
+	var c DeleteCommand
+	c.Globals = globals
+	c.manifest.File.Read(manifest.Filename)
+
+	c.CmdClause = parent.Command("delete", "Delete a VCL snippet from a Fastly service version")
+
+	c.CmdClause.Flag("service-id", "Service ID").Short('s').StringVar(&c.manifest.Flag.ServiceID)
+	c.CmdClause.Flag("version", "Number of service version").Required().IntVar(&c.Version)
+	c.CmdClause.Flag("name", "The name of the VCL snippet to delete").Short('n').Required().StringVar(&c.Name)
+
+	return &c
+}
+
+// Exec invokes the application logic for the command.
+func (c *DeleteCommand) Exec(in io.Reader, out io.Writer) error {
+	serviceID, source := c.manifest.ServiceID()
+	if source == manifest.SourceUndefined {
+		return errors.ErrNoServiceID
+	}
+
+	err := c.Globals.Client.DeleteSnippet(&fastly.DeleteSnippetInput{
+		Service: serviceID,
+		Version: c.Version,
+		Name:    c.Name,
+	})
+	if err != nil {
+		return err
+	}
+
+	text.Success(out, "Deleted VCL snippet %s (service %s version %d)", c.Name, serviceID, c.Version)
+	return nil
+}
@@ -0,0 +1,79 @@
+package snippet
+
+import (
+	"fmt"
+	"io"
+
+	"github.com/fastly/cli/pkg/common"
+	"github.com/fastly/cli/pkg/compute/manifest"
+	"github.com/fastly/cli/pkg/config"
+	"github.com/fastly/cli/pkg/errors"
+	"github.com/fastly/cli/pkg/vcl"
+	"github.com/fastly/go-fastly/fastly"
+)
+
+// DescribeCommand calls the Fastly API to describe a VCL snippet.
+type DescribeCommand struct {
+	common.Base
+	manifest manifest.Data
+
+	// required
+	Name    string
+	Version int
+}
+
+// NewDescribeCommand returns a usable command registered under the parent.
+func NewDescribeCommand(parent common.Registerer, globals *config.Data) *DescribeCommand {
+	var c DescribeCommand
+	c.Globals = globals
+	c.manifest.File.Read(manifest.Filename)
+
+	c.CmdClause = parent.Command("describe", "Show detailed information about a VCL snippet on a Fastly service version").Alias("get")
+
+	c.CmdClause.Flag("service-id", "Service ID").Short('s').StringVar(&c.manifest.Flag.ServiceID)
+	c.CmdClause.Flag("version", "Number of service version").Required().IntVar(&c.Version)
+	c.CmdClause.Flag("name", "The name of the VCL snippet").Short('n').Required().StringVar(&c.Name)
+
+	return &c
+}
+
+// Exec invokes the application logic for the command.
+func (c *DescribeCommand) Exec(in io.Reader, out io.Writer) error {
+	serviceID, source := c.manifest.ServiceID()
+	if source == manifest.SourceUndefined {
+		return errors.ErrNoServiceID
+	}
+
+	s, err := c.Globals.Client.GetSnippet(&fastly.GetSnippetInput{
+		Service: serviceID,
+		Name:    c.Name,
+		Version: c.Version,
+	})
+	if err != nil {
+		return err
+	}
+
+	fmt.Fprintf(out, "Service ID: %s\n", s.ServiceID)
+	fmt.Fprintf(out, "Version: %d\n", s.Version)
+	fmt.Fprintf(out, "Name: %s\n", s.Name)
+	fmt.Fprintf(out, "Type: %s\n", s.Type)
+	fmt.Fprintf(out, "Priority: %d\n", s.Priority)
+	fmt.Fprintf(out, "Dynamic: %t\n", s.Dynamic == 1)
+
+	content := s.Content
+	if s.Dynamic == 1 {
+		dyn, err := c.Globals.Client.GetDynamicSnippet(&fastly.GetDynamicSnippetInput{
+			Service: serviceID,
+			ID:      s.ID,
+		})
+		if err != nil {
+			return err
+		}
+		content = dyn.Content
+	}
+
+	fmt.Fprintf(out, "Content SHA1: %s\n", vcl.HashContent(content))
+	fmt.Fprintf(out, "Content:\n%s\n", content)
+
+	return nil
+}
@@ -0,0 +1,58 @@
+package snippet
+
+import (
+	"io"
+
+	"github.com/fastly/cli/pkg/common"
+	"github.com/fastly/cli/pkg/compute/manifest"
+	"github.com/fastly/cli/pkg/config"
+	"github.com/fastly/cli/pkg/errors"
+	"github.com/fastly/cli/pkg/text"
+	"github.com/fastly/go-fastly/fastly"
+)
+
+// ListCommand calls the Fastly API to list VCL snippets on a service version.
+type ListCommand struct {
+	common.Base
+	manifest manifest.Data
+	Version  int
+}
+
+// NewListCommand returns a usable command registered under the parent.
+func NewListCommand(parent common.Registerer, globals *config.Data) *ListCommand {
+	var c ListCommand
+	c.Globals = globals
+	c.manifest.File.Read(manifest.Filename)
+
+	c.CmdClause = parent.Command("list", "List VCL snippets on a Fastly service version")
+
+	c.CmdClause.Flag("service-id", "Service ID").Short('s').StringVar(&c.manifest.Flag.ServiceID)
+	c.CmdClause.Flag("version", "Number of service version").Required().IntVar(&c.Version)
+
+	return &c
+}
+
+// Exec invokes the application logic for the command.
+func (c *ListCommand) Exec(in io.Reader, out io.Writer) error {
+	serviceID, source := c.manifest.ServiceID()
+	if source == manifest.SourceUndefined {
+		return errors.ErrNoServiceID
+	}
+
+	snippets, err := c.Globals.Client.ListSnippets(&fastly.ListSnippetsInput{
+		Service: serviceID,
+		Version: c.Version,
+	})
+	if err != nil {
+		return err
+	}
+
+	tw := text.NewTable(out)
+	tw.AddHeader("SERVICE", "VERSION", "NAME", "TYPE", "PRIORITY", "DYNAMIC")
+	for _, s := range snippets {
+		tw.AddLine(s.ServiceID, s.Version, s.Name, s.Type, s.Priority, s.Dynamic == 1)
+	}
+	tw.Print()
+
+	return nil
+}
@@ -0,0 +1,146 @@
+package snippet
+
+import (
+	"io"
+
+	"github.com/fastly/cli/pkg/common"
+	"github.com/fastly/cli/pkg/compute/manifest"
+	"github.com/fastly/cli/pkg/config"
+	"github.com/fastly/cli/pkg/errors"
+	"github.com/fastly/cli/pkg/text"
+	"github.com/fastly/cli/pkg/vcl"
+	"github.com/fastly/go-fastly/fastly"
+)
+
+// UpdateCommand calls the Fastly API to update a VCL snippet on a service version.
+//
+// Dynamic snippets are updated in place by ID via UpdateDynamicSnippet and
+// don't require a new service version activation; non-dynamic snippets are
+// versioned like any other VCL object.
+type UpdateCommand struct {
+	common.Base
+	manifest manifest.Data
+
+	// required
+	Name    string
+	Version int
+
+	// optional
+	Content     common.OptionalString
+	ContentFile common.OptionalString
+	Type        common.OptionalString
+	Priority    common.OptionalInt
+}
+
+// NewUpdateCommand returns a usable command registered under the parent.
+func NewUpdateCommand(parent common.Registerer, globals *config.Data) *UpdateCommand {
+	var c UpdateCommand
+	c.Globals = globals
+	c.manifest.File.Read(manifest.Filename)
+
+	c.CmdClause = parent.Command("update", "Update a VCL snippet on a Fastly service version")
+
+	c.CmdClause.Flag("service-id", "Service ID").Short('s').StringVar(&c.manifest.Flag.ServiceID)
+	c.CmdClause.Flag("version", "Number of service version").Required().IntVar(&c.Version)
+	c.CmdClause.Flag("name", "The name of the VCL snippet to update").Short('n').Required().StringVar(&c.Name)
+
+	c.CmdClause.Flag("content", "The VCL snippet content").Action(c.Content.Set).StringVar(&c.Content.Value)
+	c.CmdClause.Flag("content-file", "Path to a file containing the VCL snippet content").Action(c.ContentFile.Set).StringVar(&c.ContentFile.Value)
+	c.CmdClause.Flag("type", "The location in generated VCL where the snippet should be inserted").Action(c.Type.Set).StringVar(&c.Type.Value)
+	c.CmdClause.Flag("priority", "Priority determines execution order of multiple snippets of the same type (lower runs first)").Action(c.Priority.Set).IntVar(&c.Priority.Value)
+
+	return &c
+}
+
+// Exec invokes the application logic for the command.
+func (c *UpdateCommand) Exec(in io.Reader, out io.Writer) error {
+	serviceID, source := c.manifest.ServiceID()
+	if source == manifest.SourceUndefined {
+		return errors.ErrNoServiceID
+	}
+
+	s, err := c.Globals.Client.GetSnippet(&fastly.GetSnippetInput{
+		Service: serviceID,
+		Name:    c.Name,
+		Version: c.Version,
+	})
+	if err != nil {
+		return err
+	}
+
+	if s.Dynamic == 1 {
+		return c.execDynamic(s, out)
+	}
+
+	input := fastly.UpdateSnippetInput{
+		Service: s.ServiceID,
+		Version: s.Version,
+		Name:    s.Name,
+		Type:    s.Type,
+		Content: s.Content,
+	}
+
+	if c.Type.Valid {
+		input.Type = fastly.SnippetType(c.Type.Value)
+	}
+
+	if c.Priority.Valid {
+		input.Priority = c.Priority.Value
+	}
+
+	if c.Content.Valid || c.ContentFile.Valid {
+		content, err := vcl.ResolveContent(c.Content.Value, c.ContentFile.Value)
+		if err != nil {
+			return err
+		}
+		input.Content = content
+	}
+
+	updated, err := c.Globals.Client.UpdateSnippet(&input)
+	if err != nil {
+		return err
+	}
+
+	text.Success(out, "Updated VCL snippet %s (service %s version %d)", updated.Name, updated.ServiceID, updated.Version)
+	return nil
+}
+
+// execDynamic updates the content of a dynamic snippet in place, skipping
+// the PUT entirely when the computed content hash already matches what's
+// configured on the service.
+func (c *UpdateCommand) execDynamic(s *fastly.Snippet, out io.Writer) error {
+	if !c.Content.Valid && !c.ContentFile.Valid {
+		text.Success(out, "No content supplied, skipping update of dynamic VCL snippet %s", s.Name)
+		return nil
+	}
+
+	content, err := vcl.ResolveContent(c.Content.Value, c.ContentFile.Value)
+	if err != nil {
+		return err
+	}
+
+	current, err := c.Globals.Client.GetDynamicSnippet(&fastly.GetDynamicSnippetInput{
+		Service: s.ServiceID,
+		ID:      s.ID,
+	})
+	if err != nil {
+		return err
+	}
+
+	if vcl.HashContent(content) == vcl.HashContent(current.Content) {
+		text.Success(out, "Dynamic VCL snippet %s is already up to date, skipping update", s.Name)
+		return nil
+	}
+
+	updated, err := c.Globals.Client.UpdateDynamicSnippet(&fastly.UpdateDynamicSnippetInput{
+		Service: s.ServiceID,
+		ID:      s.ID,
+		Content: content,
+	})
+	if err != nil {
+		return err
+	}
+
+	text.Success(out, "Updated dynamic VCL snippet %s (service %s)", s.Name, updated.ServiceID)
+	return nil
+}